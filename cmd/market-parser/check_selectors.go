@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/adapters/browser/chromium"
+	"github.com/vo1dFl0w/market-parser/internal/adapters/parsers"
+	"github.com/vo1dFl0w/market-parser/internal/config"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/usecase"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+var errSelectorsUnhealthy = errors.New("required selectors failed")
+
+// runCheckSelectors проверяет селекторы агрегатора на живом сайте и печатает отчёт в stdout.
+// Если обязательный селектор не найден, команда завершается с exitFailure, что удобно для canary перед парсингом.
+func runCheckSelectors(ctx context.Context, args []string, stdout io.Writer, stderr io.Writer) error {
+	fs := flag.NewFlagSet("check-selectors", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	provider := fs.String("provider", "", "aggregator, providers.default by default")
+	market := fs.String("market", "", "store slug, e.g. metro")
+	address := fs.String("address", "", "delivery address")
+	category := fs.String("category", "", "category to open, the category and pagination selectors are skipped without it")
+	screenshots := fs.String("screenshots", "", "directory for screenshots of failed selectors")
+	timeout := fs.Duration("timeout", 0, "check timeout, server.request_timeout by default")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: market-parser check-selectors --market <slug> --address <address> [--category <category>] [--screenshots dir]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return usageError(err)
+	}
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return usageError(fmt.Errorf("unexpected argument %q", fs.Arg(0)))
+	}
+	if *market == "" || *address == "" {
+		fs.Usage()
+		return usageError(errors.New("--market and --address are required"))
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	loggerCfg := logger.NewLoggerConfig(cfg.Server.Env, cfg.Options.LoggerTimeFormat).WithOutput(stderr)
+	logger := logger.LoadLogger(loggerCfg)
+
+	chromiumRepo := chromium.NewChromium(cfg, logger)
+	if err := chromiumRepo.Start(ctx); err != nil {
+		closeBrowserPool(chromiumRepo, cfg.Server.ShutdownTimeout)
+		return fmt.Errorf("start chromium: %w", err)
+	}
+	defer closeBrowserPool(chromiumRepo, cfg.Server.ShutdownTimeout)
+	browserRepo := chromium.NewBrowser(chromiumRepo)
+
+	// проверка не должна опираться на сохранённую сессию, поэтому адрес вводится заново
+	parserRegistry, err := parsers.NewProviderRegistry(cfg, logger, browserRepo.Chromium(), nil)
+	if err != nil {
+		return fmt.Errorf("new provider registry: %w", err)
+	}
+	diagnosticsSrv := usecase.NewDiagnosticsService(parserRegistry)
+
+	if *timeout <= 0 {
+		*timeout = cfg.Server.RequestTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+
+	report, err := diagnosticsSrv.CheckSelectors(ctx, *provider, *market, *address, *category)
+	if err != nil {
+		return err
+	}
+
+	if err := printSelectorReport(stdout, report); err != nil {
+		return err
+	}
+	if *screenshots != "" {
+		if err := writeScreenshots(*screenshots, report); err != nil {
+			return err
+		}
+	}
+
+	if !report.Healthy() {
+		return errSelectorsUnhealthy
+	}
+
+	return nil
+}
+
+func printSelectorReport(w io.Writer, report *domain.SelectorReport) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "STATUS\tPAGE\tNAME\tREQUIRED\tTIME\tSELECTOR")
+	for _, c := range report.Checks {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%t\t%s\t%s\n", c.Status, c.Page, c.Name, c.Required, c.Duration.Round(time.Millisecond), c.Selector)
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("print report: %w", err)
+	}
+
+	for _, c := range report.Checks {
+		if c.Failed() && c.Err != "" {
+			fmt.Fprintf(w, "%s: %s\n", c.Name, c.Err)
+		}
+	}
+
+	status := "healthy"
+	if !report.Healthy() {
+		status = "unhealthy"
+	}
+	fmt.Fprintf(w, "%s: %s, %d selectors in %s\n", report.Provider, status, len(report.Checks), report.Duration.Round(time.Second))
+
+	return nil
+}
+
+// writeScreenshots сохраняет снимки страниц упавших селекторов как <dir>/<name>.png.
+func writeScreenshots(dir string, report *domain.SelectorReport) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("create %s: %w", dir, err)
+	}
+
+	for _, c := range report.Checks {
+		if len(c.Screenshot) == 0 {
+			continue
+		}
+		path := filepath.Join(dir, c.Name+".png")
+		if err := os.WriteFile(path, c.Screenshot, 0o644); err != nil {
+			return fmt.Errorf("write %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+// parsedProduct - товар из сохранённого ответа /parse. Для сравнения нужны не все поля.
+type parsedProduct struct {
+	Name    string  `json:"name"`
+	Price   float64 `json:"price"`
+	Link    string  `json:"link"`
+	SKU     *string `json:"sku"`
+	InStock *bool   `json:"in_stock"`
+}
+
+// runDiff сравнивает два сохранённых ответа /parse: market-parser diff old.json new.json.
+func runDiff(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: market-parser diff <old.json> <new.json>")
+	}
+	if err := fs.Parse(args); err != nil {
+		return usageError(err)
+	}
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return usageError(errors.New("diff expects two files"))
+	}
+
+	before, err := readParseOutput(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	after, err := readParseOutput(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	printDiff(stdout, domain.DiffProducts(before, after))
+
+	return nil
+}
+
+func readParseOutput(path string) ([]domain.Products, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	parsed := []parsedProduct{}
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, fmt.Errorf("decode %s: %w", path, err)
+	}
+
+	res := make([]domain.Products, 0, len(parsed))
+	for _, p := range parsed {
+		res = append(res, domain.Products{Name: p.Name, Price: p.Price, URL: p.Link, SKU: p.SKU, InStock: p.InStock})
+	}
+
+	return res, nil
+}
+
+func printDiff(w io.Writer, d *domain.SnapshotDiff) {
+	fmt.Fprintf(w, "added: %d\n", len(d.Added))
+	for _, p := range d.Added {
+		fmt.Fprintf(w, "  + %s  %.2f  %s\n", p.Name, p.Price, p.URL)
+	}
+	fmt.Fprintf(w, "removed: %d\n", len(d.Removed))
+	for _, p := range d.Removed {
+		fmt.Fprintf(w, "  - %s  %.2f  %s\n", p.Name, p.Price, p.URL)
+	}
+	fmt.Fprintf(w, "price up: %d\n", len(d.PriceUp))
+	for _, c := range d.PriceUp {
+		fmt.Fprintf(w, "  ^ %s  %.2f -> %.2f  (%+.2f, %+.2f%%)\n", c.Product.Name, c.OldPrice, c.NewPrice, c.Delta, c.DeltaPercent)
+	}
+	fmt.Fprintf(w, "price down: %d\n", len(d.PriceDown))
+	for _, c := range d.PriceDown {
+		fmt.Fprintf(w, "  v %s  %.2f -> %.2f  (%+.2f, %+.2f%%)\n", c.Product.Name, c.OldPrice, c.NewPrice, c.Delta, c.DeltaPercent)
+	}
+	fmt.Fprintf(w, "stock changed: %d\n", len(d.StockChanged))
+	for _, c := range d.StockChanged {
+		fmt.Fprintf(w, "  %s  %s -> %s\n", c.Product.Name, stockLabel(c.WasInStock), stockLabel(c.InStock))
+	}
+}
+
+func stockLabel(inStock bool) string {
+	if inStock {
+		return "in stock"
+	}
+	return "out of stock"
+}
@@ -3,94 +3,109 @@ package main
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
-	"log"
-	"net/http"
+	"io"
 	"os"
 	"os/signal"
 	"syscall"
 
-	"github.com/vo1dFl0w/market-parser/internal/adapters/browser/chromium"
-	"github.com/vo1dFl0w/market-parser/internal/adapters/parsers"
-	"github.com/vo1dFl0w/market-parser/internal/config"
-	ht "github.com/vo1dFl0w/market-parser/internal/transport/http"
-	"github.com/vo1dFl0w/market-parser/internal/transport/http/httpgen"
-	"github.com/vo1dFl0w/market-parser/internal/usecase"
-	"github.com/vo1dFl0w/market-parser/pkg/logger"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
 )
 
-// 1. зайти на главную страницу купер
-// 2. ввести адрес доставки
-// 3. параллельно открыть несколько страниц с магазинами (лента, магнит, пятёрочка и так далее)
-// 4. на каждой странице нажать на категорию справа (категорию получаем в url get-запроса)
-// 5. параллельное открытие нескольких страниц для каждого магазина (page=1, page=2 и так далее)
-// 6. парсинг страницы из полученного json
-
-func main() {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	if err := run(ctx); err != nil {
-		log.Println(ctx, "startup", "err", err)
-		os.Exit(1)
-	}
-}
+// коды выхода
+const (
+	exitOK          = 0
+	exitFailure     = 1
+	exitUsage       = 2
+	exitTimeout     = 3
+	exitBlocked     = 4
+	exitInterrupted = 130
+)
 
-func run(ctx context.Context) error {
-	cfg, err := config.LoadConfig()
-	if err != nil {
-		return fmt.Errorf("load config: %w", err)
-	}
+const usage = `usage: market-parser <command> [flags]
 
-	loggerCfg := logger.NewLoggerConfig(cfg.Server.Env, cfg.Options.LoggerTimeFormat)
-	logger := logger.LoadLogger(loggerCfg)
+commands:
+  serve   start the http server (default)
+  parse   parse a category once and write the products to a file or stdout
+  diff    compare two saved /parse outputs
+  check-selectors
+          check the configured selectors against the live site
 
-	chromiumRepo := chromium.NewChromium(cfg, logger)
-	browserRepo := chromium.NewBrowser(chromiumRepo)
-	kuperParser := parsers.NewKuperParser(cfg, logger, browserRepo.Chromium())
-	parserSrv := usecase.NewParserService(kuperParser)
+run "market-parser <command> -h" for the command flags
+`
 
-	handler := ht.NewHandler(logger, parserSrv, cfg.Server.RequestTimeout)
+var errUsage = errors.New("usage")
 
-	srv, err := httpgen.NewServer(handler)
-	if err != nil {
-		return fmt.Errorf("new server: %w", err)
+// usageError помечает ошибку разбора аргументов, чтобы main вышла с exitUsage.
+func usageError(err error) error {
+	if errors.Is(err, flag.ErrHelp) {
+		return err
 	}
+	return fmt.Errorf("%w: %w", errUsage, err)
+}
 
-	withMiddlewares := handler.CORSMiddleware(handler.RequestTimeoutMiddleware(handler.LoggerMiddleware(srv)))
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
 
-	httpServer := http.Server{
-		Addr:    cfg.Server.HTTPAddr,
-		Handler: withMiddlewares,
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	cmd := "serve"
+	if len(args) > 0 {
+		cmd, args = args[0], args[1:]
 	}
 
-	sig := make(chan os.Signal, 1)
-	signal.Notify(sig, syscall.SIGTERM, syscall.SIGINT)
-	serverErr := make(chan error, 1)
-
-	go func() {
-		logger.Info("server started", "host", httpServer.Addr)
-		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			serverErr <- err
-		} else {
-			serverErr <- nil
-		}
-	}()
-
-	select {
-	case e := <-serverErr:
-		return fmt.Errorf("server error: %w", e)
-	case s := <-sig:
-		logger.Info("initialization gracefull shutdown", "signal", s)
+	var err error
+	switch cmd {
+	case "serve":
+		// serve сам обрабатывает сигналы и останавливается штатно
+		err = runServe(context.Background(), args)
+	case "parse":
+		ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		err = runParse(ctx, args, stdout, stderr)
+		stop()
+	case "diff":
+		err = runDiff(args, stdout)
+	case "check-selectors":
+		ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		err = runCheckSelectors(ctx, args, stdout, stderr)
+		stop()
+	case "help", "-h", "--help":
+		fmt.Fprint(stdout, usage)
+		return exitOK
+	default:
+		fmt.Fprint(stderr, usage)
+		fmt.Fprintf(stderr, "unknown command %q\n", cmd)
+		return exitUsage
+	}
 
-		shutdownCtx, cancel := context.WithTimeout(ctx, cfg.Server.ShutdownTimeout)
-		defer cancel()
+	if err != nil && !errors.Is(err, flag.ErrHelp) {
+		fmt.Fprintf(stderr, "%s: %v\n", cmd, err)
+	}
 
-		if err := httpServer.Shutdown(shutdownCtx); err != nil {
-			return fmt.Errorf("shutdown http server: %w", err)
-		}
+	return exitCode(err)
+}
 
-		logger.Info("server gracefully stopped")
-		return nil
+func exitCode(err error) int {
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return exitOK
+	case errors.Is(err, errUsage),
+		errors.Is(err, domain.ErrEmptyCategory),
+		errors.Is(err, domain.ErrEmptyAddress),
+		errors.Is(err, domain.ErrEmptyMarket),
+		errors.Is(err, domain.ErrUnknownParseMode),
+		errors.Is(err, domain.ErrUnknownProvider),
+		errors.Is(err, domain.ErrNotSupported),
+		errors.Is(err, domain.ErrUnknownExportFormat):
+		return exitUsage
+	case errors.Is(err, context.DeadlineExceeded):
+		return exitTimeout
+	case errors.Is(err, domain.ErrCaptchaBlocked):
+		return exitBlocked
+	case errors.Is(err, context.Canceled):
+		return exitInterrupted
+	default:
+		return exitFailure
 	}
 }
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/adapters/browser/chromium"
+	"github.com/vo1dFl0w/market-parser/internal/adapters/parsers"
+	"github.com/vo1dFl0w/market-parser/internal/config"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/export"
+	"github.com/vo1dFl0w/market-parser/internal/usecase"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+// runParse парсит одну категорию без http-сервера и пишет товары в --out или stdout.
+// Логи и ход парсинга выводятся в stderr, чтобы stdout можно было перенаправить в файл.
+func runParse(ctx context.Context, args []string, stdout io.Writer, stderr io.Writer) error {
+	fs := flag.NewFlagSet("parse", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	provider := fs.String("provider", "", "aggregator, providers.default by default")
+	market := fs.String("market", "", "store slug, e.g. metro")
+	address := fs.String("address", "", "delivery address")
+	category := fs.String("category", "", "full category name")
+	mode := fs.String("mode", "", "browser or api, providers.<provider>.mode by default")
+	out := fs.String("out", "", "output file, the format follows the extension: .json, .csv, .xlsx, .parquet (stdout JSON by default)")
+	format := fs.String("format", "", "output format: json, csv, xlsx or parquet, overrides the --out extension")
+	timeout := fs.Duration("timeout", 0, "parse timeout, server.request_timeout by default")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: market-parser parse [--provider <name>] --market <slug> --address <address> --category <category> [--out products.csv]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return usageError(err)
+	}
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return usageError(fmt.Errorf("unexpected argument %q", fs.Arg(0)))
+	}
+	if *market == "" || *address == "" || *category == "" {
+		fs.Usage()
+		return usageError(errors.New("--market, --address and --category are required"))
+	}
+
+	outFormat := export.FormatFromPath(*out)
+	if *format != "" {
+		f, err := export.ParseFormat(*format)
+		if err != nil {
+			return err
+		}
+		outFormat = f
+	}
+	if *out == "" && (outFormat == export.FormatXLSX || outFormat == export.FormatParquet) {
+		return usageError(fmt.Errorf("%s output needs --out", outFormat))
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	loggerCfg := logger.NewLoggerConfig(cfg.Server.Env, cfg.Options.LoggerTimeFormat).WithOutput(stderr)
+	logger := logger.LoadLogger(loggerCfg)
+
+	chromiumRepo := chromium.NewChromium(cfg, logger)
+	if err := chromiumRepo.Start(ctx); err != nil {
+		closeBrowserPool(chromiumRepo, cfg.Server.ShutdownTimeout)
+		return fmt.Errorf("start chromium: %w", err)
+	}
+	defer closeBrowserPool(chromiumRepo, cfg.Server.ShutdownTimeout)
+	browserRepo := chromium.NewBrowser(chromiumRepo)
+
+	db := &sqliteDB{path: cfg.Storage.SQLitePath}
+	defer db.close()
+	sessionRepo, err := newSessionRepository(ctx, cfg, db)
+	if err != nil {
+		return fmt.Errorf("new session repository: %w", err)
+	}
+
+	parserRegistry, err := parsers.NewProviderRegistry(cfg, logger, browserRepo.Chromium(), sessionRepo)
+	if err != nil {
+		return fmt.Errorf("new provider registry: %w", err)
+	}
+	// разовый парсинг не сохраняет снимки
+	parserSrv := usecase.NewParserService(parserRegistry, nil)
+
+	if *timeout <= 0 {
+		*timeout = cfg.Server.RequestTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+
+	progress := &parseProgress{w: stderr, start: time.Now()}
+	ctx = domain.WithParseHooks(ctx, &domain.ParseHooks{
+		OnPages: progress.pages,
+		OnPage:  progress.page,
+	})
+
+	products, parseErr := parserSrv.ParseProductsByCategory(ctx, *provider, *category, *address, *market, domain.ParseMode(*mode))
+	// при неразобранных страницах товары остальных всё равно записываются, а команда завершается с ошибкой
+	if parseErr != nil && len(products) == 0 {
+		return parseErr
+	}
+
+	if err := writeProducts(*out, outFormat, products, stdout); err != nil {
+		return err
+	}
+
+	dest := *out
+	if dest == "" {
+		dest = "stdout"
+	}
+	fmt.Fprintf(stderr, "done: %d products in %s -> %s\n", len(products), time.Since(progress.start).Round(time.Second), dest)
+
+	return parseErr
+}
+
+// writeProducts пишет файл через временный, чтобы при ошибке не оставить наполовину записанный результат.
+func writeProducts(path string, format export.Format, products []domain.Products, stdout io.Writer) error {
+	if path == "" {
+		return export.Write(stdout, format, products)
+	}
+
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", tmp, err)
+	}
+	if err := export.Write(f, format, products); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("close %s: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("rename %s: %w", tmp, err)
+	}
+
+	return nil
+}
+
+// parseProgress печатает ход парсинга в stderr. Страницы разбираются параллельно.
+type parseProgress struct {
+	w     io.Writer
+	start time.Time
+
+	mu       sync.Mutex
+	total    int
+	done     int
+	products int
+}
+
+func (p *parseProgress) pages(total int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.total = total
+	fmt.Fprintf(p.w, "pages: %d\n", total)
+}
+
+func (p *parseProgress) page(pageNum int, products []domain.Products) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.done++
+	p.products += len(products)
+	fmt.Fprintf(p.w, "page %d done (%d/%d), %d products\n", pageNum, p.done, p.total, p.products)
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/adapters/browser/chromium"
+	"github.com/vo1dFl0w/market-parser/internal/adapters/notify"
+	"github.com/vo1dFl0w/market-parser/internal/adapters/parsers"
+	"github.com/vo1dFl0w/market-parser/internal/adapters/webhook"
+	"github.com/vo1dFl0w/market-parser/internal/config"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+	ht "github.com/vo1dFl0w/market-parser/internal/transport/http"
+	"github.com/vo1dFl0w/market-parser/internal/transport/http/httpgen"
+	"github.com/vo1dFl0w/market-parser/internal/usecase"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+// runServe запускает http-сервер и фоновые сервисы до SIGINT/SIGTERM.
+func runServe(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: market-parser serve")
+	}
+	if err := fs.Parse(args); err != nil {
+		return usageError(err)
+	}
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return usageError(fmt.Errorf("unexpected argument %q", fs.Arg(0)))
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	loggerCfg := logger.NewLoggerConfig(cfg.Server.Env, cfg.Options.LoggerTimeFormat)
+	logger := logger.LoadLogger(loggerCfg)
+
+	chromiumRepo := chromium.NewChromium(cfg, logger)
+	if err := chromiumRepo.Start(ctx); err != nil {
+		// браузеры, запущенные до ошибки, уже в пуле
+		closeBrowserPool(chromiumRepo, cfg.Server.ShutdownTimeout)
+		return fmt.Errorf("start chromium: %w", err)
+	}
+	// пул закрывается на любом выходе из runServe, повторный Close после штатной остановки ничего не делает
+	defer closeBrowserPool(chromiumRepo, cfg.Server.ShutdownTimeout)
+	browserRepo := chromium.NewBrowser(chromiumRepo)
+	db := &sqliteDB{path: cfg.Storage.SQLitePath}
+	defer db.close()
+	pgDB := &postgresDB{dsn: cfg.Storage.PostgresDSN}
+	defer pgDB.close()
+
+	sessionRepo, err := newSessionRepository(ctx, cfg, db)
+	if err != nil {
+		return fmt.Errorf("new session repository: %w", err)
+	}
+	deliveryRepo, err := newDeliveryRepository(ctx, cfg, db)
+	if err != nil {
+		return fmt.Errorf("new delivery repository: %w", err)
+	}
+
+	parserRegistry, err := parsers.NewProviderRegistry(cfg, logger, browserRepo.Chromium(), sessionRepo)
+	if err != nil {
+		return fmt.Errorf("new provider registry: %w", err)
+	}
+	snapshotRepo, err := newSnapshotRepository(ctx, cfg, db, pgDB)
+	if err != nil {
+		return fmt.Errorf("new snapshot repository: %w", err)
+	}
+	alertRepo, err := newAlertRepository(ctx, cfg, db)
+	if err != nil {
+		return fmt.Errorf("new alert repository: %w", err)
+	}
+	notifiers := map[domain.AlertNotifier]repository.AlertNotifier{
+		domain.AlertNotifierLog:     notify.NewLogNotifier(logger),
+		domain.AlertNotifierWebhook: notify.NewWebhookNotifier(cfg.Webhooks.Secret, cfg.Alerts.NotifyTimeout),
+	}
+	if cfg.Alerts.TelegramBotToken != "" {
+		notifiers[domain.AlertNotifierTelegram] = notify.NewTelegramNotifier(cfg.Alerts.TelegramBaseURL, cfg.Alerts.TelegramBotToken, cfg.Alerts.NotifyTimeout)
+	}
+	alertSrv := usecase.NewAlertService(logger, alertRepo, notifiers, cfg.Alerts.NotifyTimeout)
+	snapshotSrv := usecase.NewSnapshotService(logger, snapshotRepo, alertSrv, parserRegistry.DefaultProvider())
+	parserSrv := usecase.NewParserService(parserRegistry, snapshotSrv)
+
+	catalogSrv := usecase.NewCatalogService(parserRegistry, cfg.Server.CatalogCacheTTL)
+
+	if cfg.Webhooks.Secret == "" {
+		logger.Warn("webhooks.secret is empty, webhook signatures can be forged")
+	}
+	webhookSender := webhook.NewSender(cfg.Webhooks.Secret, cfg.Webhooks.Timeout, cfg.Webhooks.AllowedHosts, ht.ErrorMessage)
+	webhookSrv := usecase.NewWebhookService(logger, deliveryRepo, webhookSender, cfg.Webhooks.Timeout, cfg.Webhooks.MaxAttempts, cfg.Webhooks.InitialBackoff, cfg.Webhooks.MaxBackoff)
+
+	jobSrv := usecase.NewJobService(parserSrv, webhookSrv, cfg.Jobs.Workers, cfg.Jobs.QueueSize, cfg.Jobs.Timeout, cfg.Jobs.Retention, cfg.Webhooks.AllowedHosts)
+
+	scheduleRunRepo, err := newScheduleRunRepository(ctx, cfg, db)
+	if err != nil {
+		return fmt.Errorf("new schedule run repository: %w", err)
+	}
+	schedulerSrv, err := usecase.NewSchedulerService(logger, parserSrv, scheduleRunRepo, toSchedules(cfg.Schedules), cfg.Scheduler.TargetTimeout, ht.ErrorMessage)
+	if err != nil {
+		return fmt.Errorf("new scheduler service: %w", err)
+	}
+	schedulerSrv.Start()
+
+	diagnosticsSrv := usecase.NewDiagnosticsService(parserRegistry)
+
+	handler := ht.NewHandler(logger, parserSrv, catalogSrv, jobSrv, webhookSrv, schedulerSrv, snapshotSrv, alertSrv, diagnosticsSrv, cfg.Server.RequestTimeout, cfg.Server.StreamTimeout)
+
+	srv, err := httpgen.NewServer(handler)
+	if err != nil {
+		return fmt.Errorf("new server: %w", err)
+	}
+
+	// ogen пропускает потоковый парсинг (см. api/v1/ogen.yml), он обслуживается до сгенерированного сервера
+	mux := http.NewServeMux()
+	mux.HandleFunc(ht.ParseStreamPath, handler.ParseStream)
+	// счётчики expvar, в том числе показанных и непройденных капч
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.Handle("/", srv)
+
+	withMiddlewares := handler.CORSMiddleware(handler.RequestTimeoutMiddleware(handler.LoggerMiddleware(mux)))
+
+	httpServer := http.Server{
+		Addr:    cfg.Server.HTTPAddr,
+		Handler: withMiddlewares,
+	}
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGTERM, syscall.SIGINT)
+	serverErr := make(chan error, 1)
+
+	go func() {
+		logger.Info("server started", "host", httpServer.Addr)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serverErr <- err
+		} else {
+			serverErr <- nil
+		}
+	}()
+
+	select {
+	case e := <-serverErr:
+		closeCtx, cancel := context.WithTimeout(ctx, cfg.Server.ShutdownTimeout)
+		defer cancel()
+
+		return errors.Join(fmt.Errorf("server error: %w", e), closeServices(closeCtx, schedulerSrv, jobSrv, alertSrv, webhookSrv, chromiumRepo))
+	case s := <-sig:
+		logger.Info("initialization gracefull shutdown", "signal", s)
+
+		shutdownCtx, cancel := context.WithTimeout(ctx, cfg.Server.ShutdownTimeout)
+		defer cancel()
+
+		var errs []error
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			errs = append(errs, fmt.Errorf("shutdown http server: %w", err))
+		}
+		if err := closeServices(shutdownCtx, schedulerSrv, jobSrv, alertSrv, webhookSrv, chromiumRepo); err != nil {
+			errs = append(errs, err)
+		}
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+
+		logger.Info("server gracefully stopped")
+		return nil
+	}
+}
+
+type closer interface {
+	Close(ctx context.Context) error
+}
+
+// closeServices останавливает фоновые сервисы и пул браузеров. Ошибка одного сервиса не мешает
+// остановить остальные: все ошибки собираются в одну.
+func closeServices(ctx context.Context, scheduler, jobs, alerts, webhooks, browsers closer) error {
+	// задачи и расписания держат браузеры из пула, поэтому останавливаются раньше него
+	var errs []error
+	if err := scheduler.Close(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("close scheduler: %w", err))
+	}
+	if err := jobs.Close(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("close job service: %w", err))
+	}
+	if err := alerts.Close(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("close alert service: %w", err))
+	}
+	if err := webhooks.Close(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("close webhook service: %w", err))
+	}
+	if err := browsers.Close(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("close browser pool: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// closeBrowserPool закрывает пул на выходе из команды, в том числе после неудачного Start.
+func closeBrowserPool(ch *chromium.Chromium, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	ch.Close(ctx)
+}
+
+func toSchedules(cfg []config.ScheduleConfig) []domain.Schedule {
+	res := make([]domain.Schedule, 0, len(cfg))
+	for _, sc := range cfg {
+		schedule := domain.Schedule{
+			Name:    sc.Name,
+			Cron:    sc.Cron,
+			Jitter:  sc.Jitter,
+			Mode:    domain.ParseMode(sc.Mode),
+			Targets: make([]domain.ScheduleTarget, 0, len(sc.Targets)),
+		}
+		for _, t := range sc.Targets {
+			schedule.Targets = append(schedule.Targets, domain.ScheduleTarget(t))
+		}
+		res = append(res, schedule)
+	}
+
+	return res
+}
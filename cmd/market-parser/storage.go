@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/vo1dFl0w/market-parser/internal/adapters/storage/filestore"
+	"github.com/vo1dFl0w/market-parser/internal/adapters/storage/memory"
+	"github.com/vo1dFl0w/market-parser/internal/adapters/storage/postgres"
+	"github.com/vo1dFl0w/market-parser/internal/adapters/storage/sqlite"
+	"github.com/vo1dFl0w/market-parser/internal/config"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+)
+
+// sqliteDB открывает базу при первом обращении, чтобы файл не создавался, если sqlite не выбран ни для одного хранилища.
+type sqliteDB struct {
+	path string
+	db   *sql.DB
+}
+
+func (d *sqliteDB) open(ctx context.Context) (*sql.DB, error) {
+	if d.db != nil {
+		return d.db, nil
+	}
+
+	db, err := sqlite.Open(ctx, d.path)
+	if err != nil {
+		return nil, err
+	}
+	d.db = db
+
+	return db, nil
+}
+
+func (d *sqliteDB) close() {
+	if d.db != nil {
+		d.db.Close()
+	}
+}
+
+// postgresDB подключается к postgres только если он выбран хотя бы для одного хранилища.
+type postgresDB struct {
+	dsn string
+	db  *sql.DB
+}
+
+func (d *postgresDB) open(ctx context.Context) (*sql.DB, error) {
+	if d.db != nil {
+		return d.db, nil
+	}
+	if d.dsn == "" {
+		return nil, errors.New("storage.postgres_dsn is empty")
+	}
+
+	db, err := postgres.Open(ctx, d.dsn)
+	if err != nil {
+		return nil, err
+	}
+	d.db = db
+
+	return db, nil
+}
+
+func (d *postgresDB) close() {
+	if d.db != nil {
+		d.db.Close()
+	}
+}
+
+// newSessionRepository выбирает хранилище сессий с адресом доставки по sessions.store.
+func newSessionRepository(ctx context.Context, cfg *config.Config, db *sqliteDB) (repository.SessionRepository, error) {
+	switch cfg.Sessions.Store {
+	case "none", "":
+		return nil, nil
+	case "file":
+		return filestore.NewSessionRepository(cfg.Sessions.Dir, cfg.Sessions.TTL)
+	case "sqlite":
+		sqlDB, err := db.open(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return sqlite.NewSessionRepository(sqlDB, cfg.Sessions.TTL), nil
+	default:
+		return nil, fmt.Errorf("unknown sessions store %q", cfg.Sessions.Store)
+	}
+}
+
+// newDeliveryRepository выбирает хранилище журнала доставок webhook по webhooks.log_store.
+func newDeliveryRepository(ctx context.Context, cfg *config.Config, db *sqliteDB) (repository.DeliveryRepository, error) {
+	switch cfg.Webhooks.LogStore {
+	case "memory", "":
+		return memory.NewDeliveryRepository(cfg.Webhooks.LogLimit), nil
+	case "sqlite":
+		sqlDB, err := db.open(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return sqlite.NewDeliveryRepository(sqlDB), nil
+	default:
+		return nil, fmt.Errorf("unknown webhooks log store %q", cfg.Webhooks.LogStore)
+	}
+}
+
+// newScheduleRunRepository выбирает хранилище истории запусков расписаний по scheduler.history_store.
+func newScheduleRunRepository(ctx context.Context, cfg *config.Config, db *sqliteDB) (repository.ScheduleRunRepository, error) {
+	switch cfg.Scheduler.HistoryStore {
+	case "memory", "":
+		return memory.NewScheduleRunRepository(cfg.Scheduler.HistoryLimit), nil
+	case "sqlite":
+		sqlDB, err := db.open(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return sqlite.NewScheduleRunRepository(sqlDB), nil
+	default:
+		return nil, fmt.Errorf("unknown scheduler history store %q", cfg.Scheduler.HistoryStore)
+	}
+}
+
+// newAlertRepository выбирает хранилище правил оповещений по alerts.store.
+func newAlertRepository(ctx context.Context, cfg *config.Config, db *sqliteDB) (repository.AlertRepository, error) {
+	switch cfg.Alerts.Store {
+	case "memory":
+		return memory.NewAlertRepository(), nil
+	case "sqlite", "":
+		sqlDB, err := db.open(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return sqlite.NewAlertRepository(sqlDB), nil
+	default:
+		return nil, fmt.Errorf("unknown alerts store %q", cfg.Alerts.Store)
+	}
+}
+
+// newSnapshotRepository выбирает хранилище снимков результатов парсинга по snapshots.store.
+func newSnapshotRepository(ctx context.Context, cfg *config.Config, db *sqliteDB, pgDB *postgresDB) (repository.SnapshotRepository, error) {
+	switch cfg.Snapshots.Store {
+	case "sqlite", "":
+		sqlDB, err := db.open(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return sqlite.NewSnapshotRepository(sqlDB), nil
+	case "postgres":
+		sqlDB, err := pgDB.open(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return postgres.NewSnapshotRepository(sqlDB), nil
+	default:
+		return nil, fmt.Errorf("unknown snapshots store %q", cfg.Snapshots.Store)
+	}
+}
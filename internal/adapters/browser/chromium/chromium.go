@@ -3,10 +3,13 @@ package chromium
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"time"
 
 	"github.com/go-rod/rod"
 	"github.com/go-rod/rod/lib/launcher"
 	"github.com/go-rod/rod/lib/proto"
+	"github.com/vo1dFl0w/market-parser/internal/adapters/browser/fixture"
 	"github.com/vo1dFl0w/market-parser/internal/config"
 	"github.com/vo1dFl0w/market-parser/internal/repository"
 	"github.com/vo1dFl0w/market-parser/pkg/logger"
@@ -15,10 +18,28 @@ import (
 type Chromium struct {
 	cfg    *Config
 	logger logger.Logger
+	pool   *pool
 }
 
 func NewChromium(cfg *config.Config, logger logger.Logger) *Chromium {
-	return &Chromium{cfg: NewConfigs(cfg), logger: logger}
+	ch := &Chromium{cfg: NewConfigs(cfg), logger: logger}
+	ch.pool = newPool(ch.cfg.Pool, logger, ch.Connect)
+
+	return ch
+}
+
+// Start заранее запускает min_size браузеров пула.
+func (ch *Chromium) Start(ctx context.Context) error {
+	if err := ch.pool.fill(ctx); err != nil {
+		return fmt.Errorf("fill browser pool: %w", err)
+	}
+
+	return nil
+}
+
+// Close дожидается завершения активных сессий и закрывает все браузеры пула.
+func (ch *Chromium) Close(ctx context.Context) error {
+	return ch.pool.close(ctx)
 }
 
 func (ch *Chromium) Connect(ctx context.Context) (*rod.Browser, error) {
@@ -53,7 +74,8 @@ func (ch *Chromium) Connect(ctx context.Context) (*rod.Browser, error) {
 			return nil, fmt.Errorf("client: %w", err)
 		}
 
-		browser = rod.New().Client(c).Trace(ch.cfg.TraceMode).Timeout(ch.cfg.SessionTimeout).Context(ctx)
+		// браузер живёт в пуле дольше одного запроса, session timeout задаётся в NewPage
+		browser = rod.New().Client(c).Trace(ch.cfg.TraceMode).Context(ctx)
 		if err := browser.Connect(); err != nil {
 			return nil, fmt.Errorf("connect browser: %w", err)
 		}
@@ -62,8 +84,9 @@ func (ch *Chromium) Connect(ctx context.Context) (*rod.Browser, error) {
 		// launch by makefile or go run
 		// must set headless=false in configs/confgi.yaml
 		// must set http_addr=localhost:8080 in configs/config.yaml
+		// headless_mode=true запускает локальный браузер без окна (e2e-тесты)
 		l := launcher.New().
-			HeadlessNew(ch.cfg.Headless).
+			HeadlessNew(ch.cfg.HeadlessMode).
 			Set("user-agent", ch.cfg.UserAgent).
 			Set("disable-blink-features", "AutomationControlled").
 			Set("disable-infobars").
@@ -85,7 +108,7 @@ func (ch *Chromium) Connect(ctx context.Context) (*rod.Browser, error) {
 		}
 
 		// set trace=true to get more logs
-		browser = rod.New().ControlURL(url).Trace(ch.cfg.TraceMode).Timeout(ch.cfg.SessionTimeout)
+		browser = rod.New().ControlURL(url).Trace(ch.cfg.TraceMode).Context(ctx)
 
 		if err := browser.Connect(); err != nil {
 			return nil, fmt.Errorf("connect browser: %w", err)
@@ -96,13 +119,15 @@ func (ch *Chromium) Connect(ctx context.Context) (*rod.Browser, error) {
 }
 
 func (ch *Chromium) NewPage(ctx context.Context, marketURL string) (repository.Page, error) {
-	browser, err := ch.Connect(ctx)
+	sess, err := ch.pool.acquire(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("connect browser: %w", err)
+		return nil, fmt.Errorf("acquire browser: %w", err)
 	}
+	browser := sess.browser.Context(ctx).Timeout(ch.cfg.SessionTimeout)
 
 	page, err := browser.Page(proto.TargetCreateTarget{})
 	if err != nil {
+		sess.release()
 		return nil, fmt.Errorf("page: %w", err)
 	}
 
@@ -110,21 +135,22 @@ func (ch *Chromium) NewPage(ctx context.Context, marketURL string) (repository.P
 		go browser.HandleAuth(ch.cfg.Proxy.Login, ch.cfg.Proxy.Password)()
 	}
 
-	if err := page.SetUserAgent(&proto.NetworkSetUserAgentOverride{
-		UserAgent:      ch.cfg.UserAgent,
-		AcceptLanguage: ch.cfg.AcceptLanguage,
-		Platform:       ch.cfg.Platoform,
-	}); err != nil {
-		return nil, fmt.Errorf("set user agent: %w", err)
+	rp := &rodPage{page: page, browser: browser, cfg: ch.cfg, session: sess, logger: ch.logger}
+	if ch.cfg.RecordDir != "" {
+		// каждая сессия пишется в свой каталог, вкладки сессии делят запись; id incognito-контекста
+		// различает сессии, начатые в одну миллисекунду
+		dir := time.Now().Format("20060102-150405.000") + "-" + string(sess.browser.BrowserContextID)
+		rp.recorder, err = fixture.NewRecorder(filepath.Join(ch.cfg.RecordDir, dir))
+		if err != nil {
+			rp.CloseBrowser()
+			return nil, fmt.Errorf("new recorder: %w", err)
+		}
+		ch.logger.Info("recording browser session", "dir", rp.recorder.Dir())
 	}
 
-	if err := page.SetViewport(&proto.EmulationSetDeviceMetricsOverride{
-		Width:             1920,
-		Height:            1080,
-		DeviceScaleFactor: 1,
-		Mobile:            false,
-	}); err != nil {
-		return nil, fmt.Errorf("set view port: %s\n", err)
+	if err := preparePage(page, ch.cfg); err != nil {
+		rp.CloseBrowser()
+		return nil, err
 	}
 
 	_, err = proto.PageNavigate{
@@ -132,11 +158,36 @@ func (ch *Chromium) NewPage(ctx context.Context, marketURL string) (repository.P
 		Referrer: ch.cfg.Referrer,
 	}.Call(page)
 	if err != nil {
+		rp.CloseBrowser()
 		return nil, fmt.Errorf("page navigate call: %w", err)
 	}
 	if err := page.WaitLoad(); err != nil {
+		rp.CloseBrowser()
 		return nil, fmt.Errorf("wait load: %w", err)
 	}
+	rp.recordPage(ctx)
+
+	return rp, nil
+}
+
+// preparePage задаёт вкладке user-agent и размер окна, одинаковые для всех вкладок сессии.
+func preparePage(page *rod.Page, cfg *Config) error {
+	if err := page.SetUserAgent(&proto.NetworkSetUserAgentOverride{
+		UserAgent:      cfg.UserAgent,
+		AcceptLanguage: cfg.AcceptLanguage,
+		Platform:       cfg.Platoform,
+	}); err != nil {
+		return fmt.Errorf("set user agent: %w", err)
+	}
+
+	if err := page.SetViewport(&proto.EmulationSetDeviceMetricsOverride{
+		Width:             1920,
+		Height:            1080,
+		DeviceScaleFactor: 1,
+		Mobile:            false,
+	}); err != nil {
+		return fmt.Errorf("set view port: %w", err)
+	}
 
-	return &rodPage{page: page, browser: browser, cfg: ch.cfg}, nil
+	return nil
 }
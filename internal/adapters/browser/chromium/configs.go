@@ -21,6 +21,7 @@ type CaptchaSelectors struct {
 type Config struct {
 	WsURL                 string
 	Headless              bool
+	HeadlessMode          bool
 	TraceMode             bool
 	UserAgent             string
 	Platoform             string
@@ -33,6 +34,10 @@ type Config struct {
 	WaitStableDuration    time.Duration
 	WaitDOMStableDuration time.Duration
 	WaitDOMStableDiff     float64
+	ParseWorkers          int
+	ParsePageRetries      int
+	Pool                  *PoolConfig
+	RecordDir             string
 }
 
 func NewConfigs(cfg *config.Config) *Config {
@@ -43,14 +48,23 @@ func NewConfigs(cfg *config.Config) *Config {
 		Password: cfg.Browser.Proxy.Password,
 	}
 
+	pool := &PoolConfig{
+		MinSize:              cfg.Browser.Pool.MinSize,
+		MaxSize:              cfg.Browser.Pool.MaxSize,
+		HealthCheckInterval:  cfg.Browser.Pool.HealthCheckInterval,
+		RecycleAfterSessions: cfg.Browser.Pool.RecycleAfterSessions,
+		RecycleMemoryMB:      cfg.Browser.Pool.RecycleMemoryMB,
+	}
+
 	captcha := &CaptchaSelectors{
-		KuperSmartCaptcha:    *cfg.Server.KuperCfg.SmartCaptchaSelector,
-		KuperCaptchaCheckBox: *cfg.Server.KuperCfg.CaptchaCheckBox,
+		KuperSmartCaptcha:    *cfg.Providers.Kuper.SmartCaptchaSelector,
+		KuperCaptchaCheckBox: *cfg.Providers.Kuper.CaptchaCheckBox,
 	}
 
 	return &Config{
 		WsURL:                 cfg.Browser.WsURL,
 		Headless:              cfg.Browser.Headless,
+		HeadlessMode:          cfg.Browser.HeadlessMode,
 		TraceMode:             cfg.Browser.TraceMode,
 		UserAgent:             cfg.Browser.UserAgent,
 		Platoform:             cfg.Browser.Platform,
@@ -63,5 +77,9 @@ func NewConfigs(cfg *config.Config) *Config {
 		WaitStableDuration:    cfg.Browser.WaitStableDuration,
 		WaitDOMStableDuration: cfg.Browser.WaitDOMStableDuration,
 		WaitDOMStableDiff:     cfg.Browser.WaitDOMStableDiff,
+		ParseWorkers:          cfg.Browser.ParseWorkers,
+		ParsePageRetries:      cfg.Browser.ParsePageRetries,
+		Pool:                  pool,
+		RecordDir:             cfg.Browser.RecordDir,
 	}
 }
@@ -1,5 +1,11 @@
 package chromium
 
+import (
+	"math"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
 type ProductsResponse struct {
 	Prods []Product `json:"products"`
 }
@@ -8,4 +14,73 @@ type Product struct {
 	Name         string  `json:"name"`
 	Price        float64 `json:"price"`
 	CanonicalURL string  `json:"canonical_url"`
+
+	ID                *string       `json:"id"`
+	SKU               *string       `json:"sku"`
+	Brand             *ProductBrand `json:"brand"`
+	Volume            *float64      `json:"volume"`
+	VolumeType        *string       `json:"volume_type"`
+	OriginalPrice     *float64      `json:"original_price"`
+	Available         *bool         `json:"available"`
+	MaxSelectQuantity *int          `json:"max_select_quantity"`
+	ImageURLs         []string      `json:"image_urls"`
+	Score             *float64      `json:"score"`
+}
+
+type ProductBrand struct {
+	Name string `json:"name"`
+}
+
+func (p *Product) ToDomain() domain.Products {
+	res := domain.Products{
+		Name:          p.Name,
+		Price:         p.Price,
+		URL:           p.CanonicalURL,
+		ID:            p.ID,
+		SKU:           p.SKU,
+		PackSize:      p.Volume,
+		PackUnit:      p.VolumeType,
+		OriginalPrice: p.OriginalPrice,
+		InStock:       p.Available,
+		MaxQuantity:   p.MaxSelectQuantity,
+		ImageURLs:     p.ImageURLs,
+		Rating:        p.Score,
+	}
+	if p.Brand != nil && p.Brand.Name != "" {
+		res.Brand = &p.Brand.Name
+	}
+	// kuper присылает original_price и без скидки, тогда он совпадает с price
+	if p.OriginalPrice != nil && *p.OriginalPrice > p.Price {
+		discount := math.Round((1 - p.Price / *p.OriginalPrice) * 100)
+		res.DiscountPercent = &discount
+	}
+
+	return res
+}
+
+type CategoriesResponse struct {
+	Categories []Category `json:"categories"`
+}
+
+type Category struct {
+	ID           int64      `json:"id"`
+	Name         string     `json:"name"`
+	Slug         string     `json:"slug"`
+	CanonicalURL string     `json:"canonical_url"`
+	Children     []Category `json:"children"`
+}
+
+func (c *Category) ToDomain() domain.Category {
+	res := domain.Category{
+		ID:       c.ID,
+		Title:    c.Name,
+		Slug:     c.Slug,
+		URL:      c.CanonicalURL,
+		Children: make([]domain.Category, 0, len(c.Children)),
+	}
+	for _, child := range c.Children {
+		res.Children = append(res.Children, child.ToDomain())
+	}
+
+	return res
 }
@@ -23,6 +23,18 @@ func (re *rodElement) Element(ctx context.Context, selector string) (repository.
 	return &rodElement{element: elem}, nil
 }
 
+func (re *rodElement) Has(ctx context.Context, selector string) (bool, repository.Element, error) {
+	b, elem, err := re.element.Timeout(workTimeout).Context(ctx).Has(selector)
+	if err != nil {
+		return false, nil, err
+	}
+	if b {
+		return b, &rodElement{element: elem}, nil
+	}
+
+	return false, nil, nil
+}
+
 func (re *rodElement) Click(ctx context.Context) error {
 	if err := re.element.Timeout(workTimeout).Context(ctx).Click(proto.InputMouseButtonLeft, 1); err != nil {
 		return err
@@ -52,6 +64,10 @@ func (re *rodElement) Attribute(ctx context.Context, attribute string) (string,
 	if err != nil {
 		return "", err
 	}
+	// атрибута нет у элемента
+	if v == nil {
+		return "", nil
+	}
 
 	return *v, nil
 }
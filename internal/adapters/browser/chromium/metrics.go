@@ -0,0 +1,7 @@
+package chromium
+
+import "expvar"
+
+// captchaStats - счётчики капчи в /debug/vars: seen - показана, solved - пройдена, blocked - не пройдена
+// за все попытки. Рост blocked отличает блокировку от поломки селекторов.
+var captchaStats = expvar.NewMap("captcha")
@@ -3,6 +3,7 @@ package chromium
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/rand"
 	"strings"
@@ -12,56 +13,105 @@ import (
 	"github.com/go-rod/rod"
 	"github.com/go-rod/rod/lib/input"
 	"github.com/go-rod/rod/lib/proto"
+	"github.com/vo1dFl0w/market-parser/internal/adapters/browser/fixture"
 	"github.com/vo1dFl0w/market-parser/internal/domain"
 	"github.com/vo1dFl0w/market-parser/internal/repository"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
 )
 
 const defaultAttemtsToSolveCaptcha int = 10
 const defaultPagesNum int = 5
 
+// parsePageRetryBackoff - пауза перед первым повтором страницы, перед каждым следующим она растёт на столько же.
+const parsePageRetryBackoff = time.Second
+
+// ErrNoProductsResponse - за WorkTimeout страница не получила ответ products.
+var ErrNoProductsResponse = errors.New("no products response")
+
 type rodPage struct {
 	browser *rod.Browser
 	page    *rod.Page
 	cfg     *Config
+	session *session
+	logger  logger.Logger
+	// recorder пишет html и ответы products сессии, nil - запись выключена
+	recorder *fixture.Recorder
+
+	mu sync.Mutex
+	// url последнего перехода вкладки, к нему привязываются записанные ответы
+	url string
 }
 
+// CheckCaptcha ждёт появления капчи и кликает по ней, пока она не пропадёт. Если капча осталась после
+// defaultAttemtsToSolveCaptcha попыток, возвращает domain.ErrCaptchaBlocked.
 func (rp *rodPage) CheckCaptcha(ctx context.Context, captchaCheckBox string, smartCaptchaSelector string) error {
+	seen := false
 	for i := 1; i <= defaultAttemtsToSolveCaptcha; i++ {
 		b, _, err := rp.page.Has(smartCaptchaSelector)
 		if err != nil {
-			return err
+			return fmt.Errorf("has captcha: %w", err)
 		}
 
-		if b {
-			time.Sleep(time.Duration(rand.Intn(500)+500) * time.Millisecond)
-			if err := rp.WaitVisible(ctx, smartCaptchaSelector); err != nil {
-				return fmt.Errorf("wait visible captcha: %w", err)
-			}
-
-			captcha, err := rp.Element(ctx, smartCaptchaSelector)
-			if err != nil {
-				return fmt.Errorf("element captcha: %w", err)
+		if !b {
+			if seen {
+				captchaStats.Add("solved", 1)
+				return nil
 			}
-
-			if err := rp.MoveCursorToElement(ctx, smartCaptchaSelector); err != nil {
-				return fmt.Errorf("move cursor to element captcha: %w", err)
+			if i < defaultAttemtsToSolveCaptcha {
+				time.Sleep(time.Millisecond * 300)
 			}
+			continue
+		}
 
-			time.Sleep(time.Duration(rand.Intn(500)+500) * time.Millisecond)
-			if err := captcha.Click(ctx); err != nil {
-				return fmt.Errorf("click captcha: %w", err)
-			}
-			time.Sleep(time.Second * 5)
-			if err := rp.WaitLoad(ctx); err != nil {
-				return fmt.Errorf("wait stable: %w", err)
-			}
-		} else {
-			if i == defaultAttemtsToSolveCaptcha {
-				break
-			}
-			time.Sleep(time.Millisecond * 300)
+		if !seen {
+			seen = true
+			captchaStats.Add("seen", 1)
+		}
+		if err := rp.clickCaptcha(ctx, smartCaptchaSelector); err != nil {
+			return err
 		}
+	}
+
+	if !seen {
+		return nil
+	}
+
+	// после последнего клика капча могла пропасть
+	b, _, err := rp.page.Has(smartCaptchaSelector)
+	if err != nil {
+		return fmt.Errorf("has captcha: %w", err)
+	}
+	if !b {
+		captchaStats.Add("solved", 1)
+		return nil
+	}
+
+	captchaStats.Add("blocked", 1)
+	return fmt.Errorf("captcha still shown after %d attempts: %w", defaultAttemtsToSolveCaptcha, domain.ErrCaptchaBlocked)
+}
 
+func (rp *rodPage) clickCaptcha(ctx context.Context, smartCaptchaSelector string) error {
+	time.Sleep(time.Duration(rand.Intn(500)+500) * time.Millisecond)
+	if err := rp.WaitVisible(ctx, smartCaptchaSelector); err != nil {
+		return fmt.Errorf("wait visible captcha: %w", err)
+	}
+
+	captcha, err := rp.Element(ctx, smartCaptchaSelector)
+	if err != nil {
+		return fmt.Errorf("element captcha: %w", err)
+	}
+
+	if err := rp.MoveCursorToElement(ctx, smartCaptchaSelector); err != nil {
+		return fmt.Errorf("move cursor to element captcha: %w", err)
+	}
+
+	time.Sleep(time.Duration(rand.Intn(500)+500) * time.Millisecond)
+	if err := captcha.Click(ctx); err != nil {
+		return fmt.Errorf("click captcha: %w", err)
+	}
+	time.Sleep(time.Second * 5)
+	if err := rp.WaitLoad(ctx); err != nil {
+		return fmt.Errorf("wait stable: %w", err)
 	}
 
 	return nil
@@ -246,59 +296,259 @@ func (rp *rodPage) SaveDeliveryAddress(ctx context.Context, addressSaveButtonSel
 	return nil
 }
 
-func (rp *rodPage) ParsePages(ctx context.Context, lastPageNum int) ([]domain.Products, error) {
-	result := []domain.Products{}
-
+func (rp *rodPage) ParsePages(ctx context.Context, firstPageNum int, lastPageNum int) ([]domain.Products, error) {
 	// формируем базовый url, для дальнейшей навигации по страницам basePageURL+&page=1,2,3...
 	basePageURL, err := rp.GetPageURL(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("get page url: %w", err)
 	}
-	// собираем информацию с каждой страницы
-	for i := 1; i <= lastPageNum; i++ {
-		// начать перехват тела ответа запроса, который содержит данные о товарах
-		//fmt.Printf("начат перехват запроса на странице %d\n", i)
-		resCh, errCh, stopListeningFn := rp.EachEvent(ctx)
 
-		targetURL := fmt.Sprintf("%s&page=%d", basePageURL, i)
-		if err := rp.Navigate(ctx, targetURL); err != nil {
-			stopListeningFn()
-			return nil, fmt.Errorf("navigate %s: %w", targetURL, err)
+	// первая вкладка - текущая, остальные открываются в той же сессии браузера
+	pagesCount := max(lastPageNum-firstPageNum+1, 0)
+	tabs := []*rodPage{rp}
+	for len(tabs) < min(rp.cfg.ParseWorkers, pagesCount) {
+		tab, err := rp.newTab()
+		if err != nil {
+			// работаем с уже открытыми вкладками
+			break
 		}
-		count := 0
-		done := false
-		for !done {
-			select {
-			case r, ok := <-resCh:
-				if !ok {
-					//fmt.Printf("Канал закрыт | Страница: %d | Итого товаров: %d\n", i, len(result))
-					done = true
-					break
-				}
-				result = append(result, r)
-				count++
-			case err, ok := <-errCh:
-				if !ok || err == nil {
+		defer tab.ClosePage()
+		tabs = append(tabs, tab)
+	}
+
+	pageNums := make(chan int, pagesCount)
+	for i := firstPageNum; i <= lastPageNum; i++ {
+		pageNums <- i
+	}
+	close(pageNums)
+
+	// результаты складываются по номеру страницы, чтобы сохранить порядок товаров
+	pages := make([][]domain.Products, pagesCount)
+	hooks := domain.ParseHooksFromContext(ctx)
+	// страница, не разобранная за все повторы, не отменяет остальные: они возвращаются вместе с ошибкой
+	failed := make([]error, pagesCount)
+	wg := &sync.WaitGroup{}
+
+	for _, tab := range tabs {
+		wg.Add(1)
+		go func(tab *rodPage) {
+			defer wg.Done()
+			for i := range pageNums {
+				targetURL := fmt.Sprintf("%s&page=%d", basePageURL, i)
+				res, err := tab.parsePageWithRetry(ctx, targetURL)
+				if err != nil {
+					failed[i-firstPageNum] = err
 					continue
 				}
-				//fmt.Printf("Получена ошибка: %s\n", err.Error())
+				hooks.Page(i, res)
+				if !hooks.Streaming() {
+					pages[i-firstPageNum] = res
+				}
+			}
+		}(tab)
+	}
+	wg.Wait()
 
-				return nil, err
-			case <-ctx.Done():
-				//fmt.Printf("Контекст завершился раньше канала!\n")
+	result := []domain.Products{}
+	pagesErr := &domain.PagesError{}
+	for i, res := range pages {
+		if failed[i] != nil {
+			pagesErr.Add(firstPageNum+i, failed[i])
+			continue
+		}
+		result = append(result, res...)
+	}
+
+	if len(pagesErr.Pages) > 0 {
+		return result, pagesErr
+	}
+
+	return result, nil
+}
 
-				stopListeningFn()
+// parsePageWithRetry повторяет парсинг страницы ParsePageRetries раз с паузой, не затрагивая остальные страницы.
+func (rp *rodPage) parsePageWithRetry(ctx context.Context, targetURL string) ([]domain.Products, error) {
+	var err error
+	for attempt := 0; attempt <= rp.cfg.ParsePageRetries; attempt++ {
+		if attempt > 0 {
+			// пауза растёт с каждой попыткой, чтобы не долбить сайт, который не успевает ответить
+			select {
+			case <-time.After(time.Duration(attempt) * parsePageRetryBackoff):
+			case <-ctx.Done():
 				return nil, ctx.Err()
 			}
 		}
-		stopListeningFn()
-		//fmt.Printf("[Parser] Данных получено: %d | страница: %d\n", count, i)
+
+		var res []domain.Products
+		res, err = rp.parsePage(ctx, targetURL)
+		if err == nil {
+			return res, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 	}
 
-	return result, nil
+	return nil, err
+}
+
+func (rp *rodPage) parsePage(ctx context.Context, targetURL string) ([]domain.Products, error) {
+	result := []domain.Products{}
+	hooks := domain.ParseHooksFromContext(ctx)
+
+	// начать перехват тела ответа запроса, который содержит данные о товарах
+	resCh, errCh, stopListeningFn := rp.EachEvent(ctx)
+	defer stopListeningFn()
+
+	if err := rp.Navigate(ctx, targetURL); err != nil {
+		return nil, fmt.Errorf("navigate %s: %w", targetURL, err)
+	}
+
+	for {
+		select {
+		case r, ok := <-resCh:
+			if !ok {
+				// слушатель кладёт ошибку до закрытия resCh, например ErrNoProductsResponse
+				if errCh != nil {
+					if err, ok := <-errCh; ok && err != nil {
+						return nil, err
+					}
+				}
+				return result, nil
+			}
+			hooks.Product(r)
+			result = append(result, r)
+		case err, ok := <-errCh:
+			if !ok {
+				errCh = nil
+				continue
+			}
+			if err == nil {
+				continue
+			}
+			return nil, err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// NewTab открывает вкладку в той же сессии браузера. Вкладку закрывают через ClosePage,
+// CloseBrowser завершает всю сессию.
+func (rp *rodPage) NewTab(ctx context.Context) (repository.Page, error) {
+	tab, err := rp.newTab()
+	if err != nil {
+		return nil, err
+	}
+
+	return tab, nil
+}
+
+// newTab открывает новую вкладку в той же сессии браузера, с общими cookies и адресом доставки.
+func (rp *rodPage) newTab() (*rodPage, error) {
+	page, err := rp.browser.Page(proto.TargetCreateTarget{})
+	if err != nil {
+		return nil, fmt.Errorf("page: %w", err)
+	}
+
+	if err := preparePage(page, rp.cfg); err != nil {
+		page.Close()
+		return nil, err
+	}
+
+	return &rodPage{page: page, browser: rp.browser, cfg: rp.cfg, session: rp.session, logger: rp.logger, recorder: rp.recorder}, nil
+}
+
+func (rp *rodPage) CaptureRequest(ctx context.Context, urlPattern string, targetURL string) (*domain.CapturedRequest, error) {
+	ctxEvent, cancel := context.WithTimeout(ctx, rp.cfg.WorkTimeout)
+	defer cancel()
+
+	if err := (proto.NetworkEnable{}).Call(rp.page); err != nil {
+		return nil, fmt.Errorf("network enable: %w", err)
+	}
+
+	// запоминаем первый запрос к api, url которого содержит urlPattern
+	var captured *proto.NetworkRequest
+	waitFn := rp.page.Context(ctxEvent).EachEvent(func(e *proto.NetworkRequestWillBeSent) bool {
+		if strings.Contains(e.Request.URL, urlPattern) {
+			captured = e.Request
+			return true
+		}
+		return false
+	})
+
+	if err := rp.Navigate(ctx, targetURL); err != nil {
+		return nil, fmt.Errorf("navigate %s: %w", targetURL, err)
+	}
+	waitFn()
+
+	if captured == nil {
+		return nil, fmt.Errorf("request %s not captured: %w", urlPattern, ctxEvent.Err())
+	}
+
+	headers := make(map[string]string, len(captured.Headers))
+	for k, v := range captured.Headers {
+		headers[k] = v.Str()
+	}
+
+	cookies, err := rp.page.Context(ctx).Cookies([]string{captured.URL})
+	if err != nil {
+		return nil, fmt.Errorf("cookies: %w", err)
+	}
+
+	return &domain.CapturedRequest{
+		URL:     captured.URL,
+		Headers: headers,
+		Cookies: toDomainCookies(cookies),
+	}, nil
+}
+
+func (rp *rodPage) CaptureResponse(ctx context.Context, urlPattern string, targetURL string) (string, error) {
+	ctxEvent, cancel := context.WithTimeout(ctx, rp.cfg.WorkTimeout)
+	defer cancel()
+
+	if err := (proto.NetworkEnable{}).Call(rp.page); err != nil {
+		return "", fmt.Errorf("network enable: %w", err)
+	}
+
+	// забираем тело первого ответа, url которого содержит urlPattern
+	var body string
+	var bodyErr error
+	waitFn := rp.page.Context(ctxEvent).EachEvent(func(r *proto.NetworkResponseReceived) bool {
+		if !strings.Contains(r.Response.URL, urlPattern) {
+			return false
+		}
+		res, err := proto.NetworkGetResponseBody{RequestID: r.RequestID}.Call(rp.page)
+		if err != nil {
+			// тело ещё не загружено, ждём следующий ответ
+			if strings.Contains(err.Error(), "-32000") {
+				return false
+			}
+			bodyErr = fmt.Errorf("network get reponse body: %w", err)
+			return true
+		}
+		body = res.Body
+		rp.recordResponse(r.Response.URL, body)
+		return true
+	})
+
+	if err := rp.Navigate(ctx, targetURL); err != nil {
+		return "", fmt.Errorf("navigate %s: %w", targetURL, err)
+	}
+	waitFn()
+
+	if bodyErr != nil {
+		return "", bodyErr
+	}
+	if body == "" {
+		return "", fmt.Errorf("response %s not captured: %w", urlPattern, ctxEvent.Err())
+	}
+
+	return body, nil
 }
 
 func (rp *rodPage) Navigate(ctx context.Context, targetURL string) error {
+	rp.setNavigatedURL(targetURL)
 	if err := rp.page.Timeout(rp.cfg.WorkTimeout).Context(ctx).Navigate(targetURL); err != nil {
 		return err
 	}
@@ -307,6 +557,7 @@ func (rp *rodPage) Navigate(ctx context.Context, targetURL string) error {
 }
 
 func (rp *rodPage) NavigateWithReferrer(ctx context.Context, marketURL string) error {
+	rp.setNavigatedURL(marketURL)
 
 	_, err := proto.PageNavigate{
 		URL:      marketURL,
@@ -329,6 +580,20 @@ func (rp *rodPage) Element(ctx context.Context, selector string) (repository.Ele
 	return &rodElement{element: elem}, err
 }
 
+func (rp *rodPage) Elements(ctx context.Context, selector string) ([]repository.Element, error) {
+	elems, err := rp.page.Timeout(rp.cfg.WorkTimeout).Context(ctx).Elements(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]repository.Element, 0, len(elems))
+	for _, elem := range elems {
+		res = append(res, &rodElement{element: elem})
+	}
+
+	return res, nil
+}
+
 func (rp *rodPage) MoveCursorToElement(ctx context.Context, selector string) error {
 	// поиск элемента и его координат
 	elem, err := rp.page.Timeout(rp.cfg.WorkTimeout).Context(ctx).Element(selector)
@@ -414,6 +679,16 @@ func (rp *rodPage) KeyboardType(ctx context.Context, key ...input.Key) error {
 	return nil
 }
 
+// Screenshot снимает видимую часть страницы в PNG.
+func (rp *rodPage) Screenshot(ctx context.Context) ([]byte, error) {
+	img, err := rp.page.Timeout(rp.cfg.WorkTimeout).Context(ctx).Screenshot(false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return img, nil
+}
+
 func (rp *rodPage) EachEvent(ctx context.Context) (<-chan domain.Products, <-chan error, func()) {
 	ctxEvent, cancel := context.WithCancel(ctx)
 
@@ -432,39 +707,43 @@ func (rp *rodPage) EachEvent(ctx context.Context) (<-chan domain.Products, <-cha
 		defer close(resCh)
 		defer close(errCh)
 
+		received := false
 		waitFn := rp.page.Timeout(rp.cfg.WorkTimeout).Context(ctxEvent).EachEvent(func(r *proto.NetworkResponseReceived) bool {
-			//fmt.Printf("[EachEvent] Запущен...\n\n")
-			if strings.Contains(r.Response.URL, "products") {
-
-				if strings.Contains(r.Response.URL, "products") {
-					res, err := proto.NetworkGetResponseBody{RequestID: r.RequestID}.Call(rp.page)
-					if err != nil {
-						if strings.Contains(err.Error(), "-32000") {
-							return false
-						}
-						errCh <- fmt.Errorf("network get reponse body: %w", err)
-						return false
-					}
+			if !strings.Contains(r.Response.URL, "products") {
+				return false
+			}
+
+			res, err := proto.NetworkGetResponseBody{RequestID: r.RequestID}.Call(rp.page)
+			if err != nil {
+				if strings.Contains(err.Error(), "-32000") {
+					return false
+				}
+				errCh <- fmt.Errorf("network get reponse body: %w", err)
+				return false
+			}
 
-					if res.Body != "" {
-						count := 0
-						data := &ProductsResponse{}
-						if err := json.Unmarshal([]byte(res.Body), &data); err == nil {
-							for _, p := range data.Prods {
-								count++
-								resCh <- domain.Products{Name: p.Name, Price: p.Price, URL: p.CanonicalURL}
-								//fmt.Printf("[EachEvent] nдобалено в page.go %v\n\n", p)
-							}
-							//fmt.Printf("[EachEvent] Обработано данных в page.go %d\n", count)
-							return true
-						}
+			if res.Body != "" {
+				rp.recordResponse(r.Response.URL, res.Body)
+				data := &ProductsResponse{}
+				if err := json.Unmarshal([]byte(res.Body), &data); err == nil {
+					for _, p := range data.Prods {
+						resCh <- p.ToDomain()
 					}
+					received = true
+					return true
 				}
 			}
 			return false
 		})
-		//fmt.Printf("[EachEvent] останавливаем слушатель...\n\n")
 		waitFn()
+
+		// истёк WorkTimeout, а ответа products не было: страница не разобрана, а не пуста
+		if !received && ctxEvent.Err() == nil {
+			select {
+			case errCh <- ErrNoProductsResponse:
+			default:
+			}
+		}
 	}()
 
 	go func() {
@@ -506,10 +785,71 @@ func (rp *rodPage) HTML(ctx context.Context) (string, error) {
 	return html, nil
 }
 
+func (rp *rodPage) Cookies(ctx context.Context) ([]domain.Cookie, error) {
+	cookies, err := rp.page.Timeout(rp.cfg.WorkTimeout).Context(ctx).Cookies(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainCookies(cookies), nil
+}
+
+func (rp *rodPage) SetCookies(ctx context.Context, cookies []domain.Cookie) error {
+	params := make([]*proto.NetworkCookieParam, 0, len(cookies))
+	for _, c := range cookies {
+		param := &proto.NetworkCookieParam{
+			Name:     c.Name,
+			Value:    c.Value,
+			Domain:   c.Domain,
+			Path:     c.Path,
+			Secure:   c.Secure,
+			HTTPOnly: c.HTTPOnly,
+		}
+		if !c.Expires.IsZero() {
+			param.Expires = proto.TimeSinceEpoch(c.Expires.Unix())
+		}
+		params = append(params, param)
+	}
+
+	if err := rp.page.Timeout(rp.cfg.WorkTimeout).Context(ctx).SetCookies(params); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (rp *rodPage) LocalStorage(ctx context.Context) (map[string]string, error) {
+	res, err := rp.page.Timeout(rp.cfg.WorkTimeout).Context(ctx).Eval(`() => Object.assign({}, window.localStorage)`)
+	if err != nil {
+		return nil, err
+	}
+
+	items := map[string]string{}
+	if err := res.Value.Unmarshal(&items); err != nil {
+		return nil, fmt.Errorf("unmarshal local storage: %w", err)
+	}
+
+	return items, nil
+}
+
+func (rp *rodPage) SetLocalStorage(ctx context.Context, items map[string]string) error {
+	_, err := rp.page.Timeout(rp.cfg.WorkTimeout).Context(ctx).Eval(`(items) => {
+		for (const [key, value] of Object.entries(items)) {
+			window.localStorage.setItem(key, value)
+		}
+	}`, items)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (rp *rodPage) WaitStable(ctx context.Context) error {
 	if err := rp.page.Timeout(rp.cfg.WorkTimeout).Context(ctx).WaitStable(rp.cfg.WaitStableDuration); err != nil {
 		return fmt.Errorf("wait stable page: %w", err)
 	}
+	rp.recordPage(ctx)
 
 	return nil
 }
@@ -518,6 +858,7 @@ func (rp *rodPage) WaitLoad(ctx context.Context) error {
 	if err := rp.page.Timeout(rp.cfg.WorkTimeout).Context(ctx).WaitLoad(); err != nil {
 		return fmt.Errorf("wait load page: %w", err)
 	}
+	rp.recordPage(ctx)
 
 	return nil
 }
@@ -526,6 +867,7 @@ func (rp *rodPage) WaitDOMStable(ctx context.Context) error {
 	if err := rp.page.Timeout(rp.cfg.WorkTimeout).Context(ctx).WaitDOMStable(rp.cfg.WaitStableDuration, rp.cfg.WaitDOMStableDiff); err != nil {
 		return fmt.Errorf("wait dom stable page: %w", err)
 	}
+	rp.recordPage(ctx)
 
 	return nil
 }
@@ -538,11 +880,13 @@ func (rp *rodPage) WaitVisible(ctx context.Context, selector string) error {
 	if err := el.Timeout(rp.cfg.WorkTimeout).Context(ctx).WaitVisible(); err != nil {
 		return err
 	}
+	rp.recordPage(ctx)
 	return nil
 }
 
 func (rp *rodPage) ClosePage() error {
 	if rp.page != nil {
+		rp.observeHeap()
 		if err := rp.page.Close(); err != nil {
 			return err
 		}
@@ -551,7 +895,12 @@ func (rp *rodPage) ClosePage() error {
 	return nil
 }
 
+// CloseBrowser закрывает incognito-контекст сессии и возвращает браузер в пул.
 func (rp *rodPage) CloseBrowser() error {
+	if rp.session != nil {
+		rp.session.release()
+		return nil
+	}
 	if rp.page != nil {
 		if err := rp.browser.Close(); err != nil {
 			return err
@@ -560,3 +909,23 @@ func (rp *rodPage) CloseBrowser() error {
 
 	return nil
 }
+
+// observeHeap передаёт пулу размер js heap вкладки перед её закрытием для решения о перезапуске браузера.
+func (rp *rodPage) observeHeap() {
+	if rp.session == nil || rp.cfg.Pool.RecycleMemoryMB <= 0 {
+		return
+	}
+
+	if err := (proto.PerformanceEnable{}).Call(rp.page); err != nil {
+		return
+	}
+	metrics, err := proto.PerformanceGetMetrics{}.Call(rp.page)
+	if err != nil {
+		return
+	}
+	for _, m := range metrics.Metrics {
+		if m.Name == "JSHeapUsedSize" {
+			rp.session.observeHeap(int(m.Value / (1 << 20)))
+		}
+	}
+}
@@ -0,0 +1,313 @@
+package chromium
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+var ErrPoolClosed = errors.New("browser pool closed")
+
+type PoolConfig struct {
+	MinSize              int
+	MaxSize              int
+	HealthCheckInterval  time.Duration
+	RecycleAfterSessions int
+	RecycleMemoryMB      int
+}
+
+// pooledBrowser - запущенный chromium, который переиспользуется между запросами.
+type pooledBrowser struct {
+	browser  *rod.Browser
+	sessions int
+}
+
+// pool держит долгоживущие браузеры и выдаёт каждому запросу отдельный incognito-контекст.
+// Браузер одновременно обслуживает только одну сессию, поэтому MaxSize ограничивает и число
+// параллельных сессий, и потребление памяти контейнером chromium.
+type pool struct {
+	cfg     *PoolConfig
+	connect func(ctx context.Context) (*rod.Browser, error)
+	logger  logger.Logger
+
+	mu     sync.Mutex
+	idle   []*pooledBrowser
+	size   int
+	closed bool
+
+	sem    chan struct{}
+	active sync.WaitGroup
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+func newPool(cfg *PoolConfig, logger logger.Logger, connect func(ctx context.Context) (*rod.Browser, error)) *pool {
+	maxSize := max(cfg.MaxSize, 1)
+
+	p := &pool{
+		cfg:     cfg,
+		connect: connect,
+		logger:  logger,
+		sem:     make(chan struct{}, maxSize),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go p.healthCheckLoop()
+
+	return p
+}
+
+// session - аренда браузера из пула на время одного запроса.
+type session struct {
+	pool    *pool
+	pb      *pooledBrowser
+	browser *rod.Browser
+
+	mu         sync.Mutex
+	heapUsedMB int
+	once       sync.Once
+}
+
+func (p *pool) acquire(ctx context.Context) (*session, error) {
+	select {
+	case p.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		<-p.sem
+		return nil, ErrPoolClosed
+	}
+	var pb *pooledBrowser
+	if n := len(p.idle); n > 0 {
+		pb = p.idle[n-1]
+		p.idle = p.idle[:n-1]
+	} else {
+		p.size++
+	}
+	p.active.Add(1)
+	p.mu.Unlock()
+
+	if pb == nil {
+		browser, err := p.connect(context.Background())
+		if err != nil {
+			p.mu.Lock()
+			p.size--
+			p.mu.Unlock()
+			p.active.Done()
+			<-p.sem
+			return nil, fmt.Errorf("connect browser: %w", err)
+		}
+		pb = &pooledBrowser{browser: browser}
+	}
+
+	// отдельный incognito-контекст изолирует cookies и localStorage запросов друг от друга
+	incognito, err := pb.browser.Incognito()
+	if err != nil {
+		p.discard(pb)
+		p.active.Done()
+		<-p.sem
+		return nil, fmt.Errorf("incognito: %w", err)
+	}
+	pb.sessions++
+
+	return &session{pool: p, pb: pb, browser: incognito}, nil
+}
+
+// observeHeap запоминает максимальный размер js heap среди вкладок сессии.
+func (s *session) observeHeap(usedMB int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.heapUsedMB = max(s.heapUsedMB, usedMB)
+}
+
+// release закрывает incognito-контекст и возвращает браузер в пул,
+// либо перезапускает его после RecycleAfterSessions сессий или превышения порога памяти.
+func (s *session) release() {
+	s.once.Do(func() {
+		p := s.pool
+		defer p.active.Done()
+		defer func() { <-p.sem }()
+
+		err := proto.TargetDisposeBrowserContext{BrowserContextID: s.browser.BrowserContextID}.Call(s.pb.browser)
+		if err != nil {
+			p.logger.Warn("dispose browser context", "error", err)
+			p.discard(s.pb)
+			return
+		}
+
+		s.mu.Lock()
+		heapUsedMB := s.heapUsedMB
+		s.mu.Unlock()
+
+		switch {
+		case p.cfg.RecycleAfterSessions > 0 && s.pb.sessions >= p.cfg.RecycleAfterSessions:
+			p.logger.Info("recycle browser", "reason", "sessions", "sessions", s.pb.sessions)
+			p.discard(s.pb)
+		case p.cfg.RecycleMemoryMB > 0 && heapUsedMB >= p.cfg.RecycleMemoryMB:
+			p.logger.Info("recycle browser", "reason", "memory", "heap_used_mb", heapUsedMB)
+			p.discard(s.pb)
+		default:
+			p.mu.Lock()
+			if p.closed {
+				p.mu.Unlock()
+				p.discard(s.pb)
+				return
+			}
+			p.idle = append(p.idle, s.pb)
+			p.mu.Unlock()
+		}
+	})
+}
+
+// discard закрывает браузер и исключает его из пула.
+func (p *pool) discard(pb *pooledBrowser) {
+	p.mu.Lock()
+	p.size--
+	p.mu.Unlock()
+
+	if err := pb.browser.Close(); err != nil {
+		p.logger.Warn("close browser", "error", err)
+	}
+}
+
+// fill дозапускает браузеры до MinSize. Браузеры живут дольше ctx, поэтому он только прерывает запуск.
+func (p *pool) fill(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		p.mu.Lock()
+		if p.closed || p.size >= min(p.cfg.MinSize, cap(p.sem)) {
+			p.mu.Unlock()
+			return nil
+		}
+		p.size++
+		p.mu.Unlock()
+
+		browser, err := p.connect(context.Background())
+		if err != nil {
+			p.mu.Lock()
+			p.size--
+			p.mu.Unlock()
+			return fmt.Errorf("connect browser: %w", err)
+		}
+
+		pb := &pooledBrowser{browser: browser}
+		p.mu.Lock()
+		if p.closed {
+			p.mu.Unlock()
+			p.discard(pb)
+			return nil
+		}
+		p.idle = append(p.idle, pb)
+		p.mu.Unlock()
+	}
+}
+
+func (p *pool) healthCheckLoop() {
+	defer close(p.done)
+
+	if p.cfg.HealthCheckInterval <= 0 {
+		<-p.stop
+		return
+	}
+
+	ticker := time.NewTicker(p.cfg.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.healthCheck()
+		}
+	}
+}
+
+// healthCheck проверяет свободные браузеры и заменяет те, что перестали отвечать.
+func (p *pool) healthCheck() {
+	p.mu.Lock()
+	idle := append([]*pooledBrowser(nil), p.idle...)
+	p.mu.Unlock()
+
+	for _, pb := range idle {
+		ctx, cancel := context.WithTimeout(context.Background(), p.cfg.HealthCheckInterval)
+		_, err := pb.browser.Context(ctx).Version()
+		cancel()
+		if err != nil && p.takeIdle(pb) {
+			p.logger.Warn("browser health check failed", "error", err)
+			p.discard(pb)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.cfg.HealthCheckInterval)
+	defer cancel()
+	if err := p.fill(ctx); err != nil {
+		p.logger.Warn("fill browser pool", "error", err)
+	}
+}
+
+// takeIdle убирает браузер из списка свободных, если его ещё не забрал запрос.
+func (p *pool) takeIdle(pb *pooledBrowser) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i, b := range p.idle {
+		if b == pb {
+			p.idle = append(p.idle[:i], p.idle[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// close перестаёт выдавать браузеры, дожидается завершения активных сессий и закрывает все браузеры.
+func (p *pool) close(ctx context.Context) error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil
+	}
+	p.closed = true
+	p.mu.Unlock()
+
+	close(p.stop)
+	<-p.done
+
+	drained := make(chan struct{})
+	go func() {
+		p.active.Wait()
+		close(drained)
+	}()
+
+	var err error
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		err = fmt.Errorf("wait active sessions: %w", ctx.Err())
+	}
+
+	p.mu.Lock()
+	idle := p.idle
+	p.idle = nil
+	p.mu.Unlock()
+
+	for _, pb := range idle {
+		p.discard(pb)
+	}
+
+	return err
+}
@@ -0,0 +1,55 @@
+package chromium
+
+import (
+	"context"
+)
+
+// recordPage сохраняет текущий html вкладки в запись сессии, если запись включена.
+// Ошибки записи не прерывают парсинг: запись нужна только для offline-тестов, поэтому они только логируются.
+func (rp *rodPage) recordPage(ctx context.Context) {
+	if rp.recorder == nil {
+		return
+	}
+
+	page := rp.page.Timeout(rp.cfg.WorkTimeout).Context(ctx)
+	info, err := page.Info()
+	if err != nil {
+		rp.logger.Warn("record page info", "dir", rp.recorder.Dir(), "error", err)
+		return
+	}
+	html, err := page.HTML()
+	if err != nil {
+		rp.logger.Warn("record page html", "dir", rp.recorder.Dir(), "url", info.URL, "error", err)
+		return
+	}
+
+	if err := rp.recorder.RecordPage(info.URL, html); err != nil {
+		rp.logger.Warn("record page", "dir", rp.recorder.Dir(), "url", info.URL, "error", err)
+	}
+}
+
+// recordResponse сохраняет тело перехваченного ответа api. Ответ привязывается к url последнего перехода
+// вкладки, по нему replay находит ответы при воспроизведении.
+func (rp *rodPage) recordResponse(responseURL string, body string) {
+	if rp.recorder == nil {
+		return
+	}
+
+	if err := rp.recorder.RecordResponse(rp.navigatedURL(), responseURL, body); err != nil {
+		rp.logger.Warn("record response", "dir", rp.recorder.Dir(), "url", responseURL, "error", err)
+	}
+}
+
+func (rp *rodPage) setNavigatedURL(targetURL string) {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+
+	rp.url = targetURL
+}
+
+func (rp *rodPage) navigatedURL() string {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+
+	return rp.url
+}
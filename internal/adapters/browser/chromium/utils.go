@@ -1,7 +1,32 @@
 package chromium
 
-import "strconv"
+import (
+	"strconv"
+
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
 
 func ParseStringToInteger(s string) (int, error) {
 	return strconv.Atoi(s)
-}
\ No newline at end of file
+}
+
+func toDomainCookies(cookies []*proto.NetworkCookie) []domain.Cookie {
+	res := make([]domain.Cookie, 0, len(cookies))
+	for _, c := range cookies {
+		cookie := domain.Cookie{
+			Name:     c.Name,
+			Value:    c.Value,
+			Domain:   c.Domain,
+			Path:     c.Path,
+			HTTPOnly: c.HTTPOnly,
+			Secure:   c.Secure,
+		}
+		if !c.Session {
+			cookie.Expires = c.Expires.Time()
+		}
+		res = append(res, cookie)
+	}
+
+	return res
+}
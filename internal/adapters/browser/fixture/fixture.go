@@ -0,0 +1,199 @@
+// Package fixture описывает запись сессии браузера: html страниц и тела перехваченных ответов api.
+// Chromium пишет запись в режиме browser.record_dir, replay отдаёт её вместо живого сайта.
+package fixture
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const ManifestFile = "manifest.json"
+
+// Manifest - оглавление записи. Пути файлов считаются от каталога записи.
+type Manifest struct {
+	Pages     []Page     `json:"pages"`
+	Responses []Response `json:"responses"`
+}
+
+// Page - снимок html страницы с адресом URL. У одного адреса может быть несколько снимков
+// в порядке записи: страница меняется после кликов без перехода на другой url.
+type Page struct {
+	URL  string `json:"url"`
+	File string `json:"file"`
+}
+
+// Response - тело ответа с адресом URL, полученного после перехода на PageURL.
+type Response struct {
+	PageURL string `json:"page_url"`
+	URL     string `json:"url"`
+	File    string `json:"file"`
+}
+
+// NormalizeURL приводит адрес страницы к виду, по которому запись ищется при воспроизведении:
+// без фрагмента, без завершающего / и с хостом в нижнем регистре.
+func NormalizeURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+
+	u.Fragment = ""
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimSuffix(u.Path, "/")
+
+	return u.String()
+}
+
+// Recorder пишет запись сессии в каталог. Безопасен для вкладок, работающих параллельно.
+type Recorder struct {
+	dir string
+
+	mu       sync.Mutex
+	manifest Manifest
+	// last - хеш последнего снимка каждого адреса, одинаковые снимки подряд не пишутся
+	last map[string][sha256.Size]byte
+}
+
+func NewRecorder(dir string) (*Recorder, error) {
+	for _, sub := range []string{"pages", "responses"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o755); err != nil {
+			return nil, fmt.Errorf("create record dir: %w", err)
+		}
+	}
+
+	return &Recorder{dir: dir, last: map[string][sha256.Size]byte{}}, nil
+}
+
+func (r *Recorder) Dir() string {
+	return r.dir
+}
+
+// RecordPage сохраняет снимок html страницы, если он отличается от предыдущего снимка того же адреса.
+func (r *Recorder) RecordPage(pageURL string, html string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := NormalizeURL(pageURL)
+	sum := sha256.Sum256([]byte(html))
+	if last, ok := r.last[key]; ok && last == sum {
+		return nil
+	}
+
+	page := Page{
+		URL:  pageURL,
+		File: filepath.ToSlash(filepath.Join("pages", fmt.Sprintf("%04d.html", len(r.manifest.Pages)+1))),
+	}
+	if err := os.WriteFile(filepath.Join(r.dir, page.File), []byte(html), 0o644); err != nil {
+		return fmt.Errorf("write page: %w", err)
+	}
+	r.manifest.Pages = append(r.manifest.Pages, page)
+	r.last[key] = sum
+
+	return r.writeManifest()
+}
+
+func (r *Recorder) RecordResponse(pageURL string, responseURL string, body string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	resp := Response{
+		PageURL: pageURL,
+		URL:     responseURL,
+		File:    filepath.ToSlash(filepath.Join("responses", fmt.Sprintf("%04d.json", len(r.manifest.Responses)+1))),
+	}
+	if err := os.WriteFile(filepath.Join(r.dir, resp.File), []byte(body), 0o644); err != nil {
+		return fmt.Errorf("write response: %w", err)
+	}
+	r.manifest.Responses = append(r.manifest.Responses, resp)
+
+	return r.writeManifest()
+}
+
+// writeManifest переписывает оглавление через временный файл, чтобы прерванная запись оставалась читаемой.
+func (r *Recorder) writeManifest() error {
+	data, err := json.MarshalIndent(r.manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal manifest: %w", err)
+	}
+
+	path := filepath.Join(r.dir, ManifestFile)
+	if err := os.WriteFile(path+".tmp", data, 0o644); err != nil {
+		return fmt.Errorf("write manifest: %w", err)
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return fmt.Errorf("rename manifest: %w", err)
+	}
+
+	return nil
+}
+
+// Fixture - загруженная запись.
+type Fixture struct {
+	dir      string
+	manifest Manifest
+}
+
+func Load(dir string) (*Fixture, error) {
+	data, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("read manifest: %w", err)
+	}
+
+	f := &Fixture{dir: dir}
+	if err := json.Unmarshal(data, &f.manifest); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+
+	return f, nil
+}
+
+// Pages возвращает снимки html страницы в порядке записи.
+func (f *Fixture) Pages(pageURL string) ([]string, error) {
+	key := NormalizeURL(pageURL)
+	res := []string{}
+	for _, p := range f.manifest.Pages {
+		if NormalizeURL(p.URL) != key {
+			continue
+		}
+		html, err := f.read(p.File)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, html)
+	}
+
+	return res, nil
+}
+
+// Responses возвращает тела ответов, полученных после перехода на pageURL, url которых содержит urlPattern.
+func (f *Fixture) Responses(pageURL string, urlPattern string) ([]string, error) {
+	key := NormalizeURL(pageURL)
+	res := []string{}
+	for _, r := range f.manifest.Responses {
+		if NormalizeURL(r.PageURL) != key || !strings.Contains(r.URL, urlPattern) {
+			continue
+		}
+		body, err := f.read(r.File)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, body)
+	}
+
+	return res, nil
+}
+
+func (f *Fixture) read(file string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(f.dir, filepath.FromSlash(file)))
+	if err != nil {
+		return "", fmt.Errorf("read fixture file: %w", err)
+	}
+
+	return string(data), nil
+}
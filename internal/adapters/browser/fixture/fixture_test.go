@@ -0,0 +1,77 @@
+package fixture
+
+import (
+	"testing"
+)
+
+func TestRecorderLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	rec, err := NewRecorder(dir)
+	if err != nil {
+		t.Fatalf("new recorder: %v", err)
+	}
+
+	steps := []struct {
+		url  string
+		html string
+	}{
+		{"https://kuper.ru/metro", "<p>1</p>"},
+		// одинаковый снимок подряд не пишется
+		{"https://kuper.ru/metro/", "<p>1</p>"},
+		{"https://kuper.ru/metro/c/myaso", "<p>category</p>"},
+		{"https://kuper.ru/metro#modal", "<p>2</p>"},
+	}
+	for _, s := range steps {
+		if err := rec.RecordPage(s.url, s.html); err != nil {
+			t.Fatalf("record page %s: %v", s.url, err)
+		}
+	}
+	pageURL := "https://kuper.ru/metro/c/myaso?all=true&page=1"
+	if err := rec.RecordResponse(pageURL, "https://kuper.ru/api/v3/banners", `{}`); err != nil {
+		t.Fatalf("record response: %v", err)
+	}
+	if err := rec.RecordResponse(pageURL, "https://kuper.ru/api/v3/products?page=1", `{"products":[]}`); err != nil {
+		t.Fatalf("record response: %v", err)
+	}
+
+	f, err := Load(dir)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	pages, err := f.Pages("https://KUPER.ru/metro")
+	if err != nil {
+		t.Fatalf("pages: %v", err)
+	}
+	if len(pages) != 2 || pages[0] != "<p>1</p>" || pages[1] != "<p>2</p>" {
+		t.Errorf("pages = %q, want [<p>1</p> <p>2</p>]", pages)
+	}
+
+	pages, err = f.Pages("https://kuper.ru/auchan")
+	if err != nil || len(pages) != 0 {
+		t.Errorf("pages of unrecorded url = %q, %v", pages, err)
+	}
+
+	bodies, err := f.Responses(pageURL, "products")
+	if err != nil {
+		t.Fatalf("responses: %v", err)
+	}
+	if len(bodies) != 1 || bodies[0] != `{"products":[]}` {
+		t.Errorf("responses = %q", bodies)
+	}
+}
+
+func TestNormalizeURL(t *testing.T) {
+	tests := map[string]string{
+		"https://kuper.ru":                "https://kuper.ru",
+		"https://kuper.ru/":               "https://kuper.ru",
+		"https://Kuper.RU/metro/#top":     "https://kuper.ru/metro",
+		"https://kuper.ru/metro?sid=12&a": "https://kuper.ru/metro?sid=12&a",
+	}
+	for in, want := range tests {
+		if got := NormalizeURL(in); got != want {
+			t.Errorf("NormalizeURL(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Package replay воспроизводит запись сессии браузера (см. fixture) вместо живого сайта.
+// Селекторы выполняются по записанному html, ответы api отдаются из записанных тел,
+// поэтому парсеры можно прогонять в go test без сети и chromium.
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/go-rod/rod"
+	"github.com/vo1dFl0w/market-parser/internal/adapters/browser/fixture"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+)
+
+type Browser struct {
+	fixture *fixture.Fixture
+}
+
+// NewBrowser загружает запись из каталога сессии, который chromium создаёт в browser.record_dir.
+func NewBrowser(dir string) (*Browser, error) {
+	f, err := fixture.Load(dir)
+	if err != nil {
+		return nil, fmt.Errorf("load fixture: %w", err)
+	}
+
+	return &Browser{fixture: f}, nil
+}
+
+func (b *Browser) Connect(ctx context.Context) (*rod.Browser, error) {
+	return nil, fmt.Errorf("replay browser connect: %w", errors.ErrUnsupported)
+}
+
+// NewPage открывает новую сессию: cookies и localStorage общие только для вкладок этой сессии.
+func (b *Browser) NewPage(ctx context.Context, marketURL string) (repository.Page, error) {
+	p := newPage(b.fixture, &storage{localStorage: map[string]string{}})
+	if err := p.Navigate(ctx, marketURL); err != nil {
+		return nil, fmt.Errorf("navigate %s: %w", marketURL, err)
+	}
+
+	return p, nil
+}
+
+// storage хранит cookies и localStorage сессии в памяти.
+type storage struct {
+	mu           sync.Mutex
+	cookies      []domain.Cookie
+	localStorage map[string]string
+}
@@ -0,0 +1,131 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/andybalholm/cascadia"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+	"golang.org/x/net/html"
+)
+
+type element struct {
+	page *page
+	node *html.Node
+}
+
+func (e *element) Element(ctx context.Context, selector string) (repository.Element, error) {
+	b, elem, err := e.Has(ctx, selector)
+	if err != nil {
+		return nil, err
+	}
+	if !b {
+		return nil, fmt.Errorf("element %s not recorded inside <%s>", selector, e.node.Data)
+	}
+
+	return elem, nil
+}
+
+func (e *element) Has(ctx context.Context, selector string) (bool, repository.Element, error) {
+	sel, err := cascadia.Compile(selector)
+	if err != nil {
+		return false, nil, fmt.Errorf("compile selector %s: %w", selector, err)
+	}
+
+	// как querySelector элемента, сам элемент в поиск не входит
+	for child := e.node.FirstChild; child != nil; child = child.NextSibling {
+		if node := sel.MatchFirst(child); node != nil {
+			return true, &element{page: e.page, node: node}, nil
+		}
+	}
+
+	return false, nil, nil
+}
+
+// Click переходит по ссылке, если элемент или его предок - <a href>. Остальные клики ничего не меняют:
+// следующее состояние страницы берётся из следующего снимка при поиске элемента.
+func (e *element) Click(ctx context.Context) error {
+	for n := e.node; n != nil; n = n.Parent {
+		if n.Type != html.ElementNode || n.Data != "a" {
+			continue
+		}
+		if href, ok := attr(n, "href"); ok && href != "" {
+			return e.page.followLink(ctx, href)
+		}
+	}
+
+	return nil
+}
+
+func (e *element) Input(ctx context.Context, text string) error {
+	setAttr(e.node, "value", text)
+	return nil
+}
+
+func (e *element) ScrollIntoView(ctx context.Context) error {
+	return nil
+}
+
+func (e *element) Attribute(ctx context.Context, attribute string) (string, error) {
+	v, _ := attr(e.node, attribute)
+	return v, nil
+}
+
+// Text возвращает текст элемента с пробелами, схлопнутыми как в innerText.
+func (e *element) Text(ctx context.Context) (string, error) {
+	var sb strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		switch {
+		case n.Type == html.TextNode:
+			sb.WriteString(n.Data)
+			sb.WriteString(" ")
+		case n.Type == html.ElementNode && (n.Data == "script" || n.Data == "style"):
+			return
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(e.node)
+
+	return strings.Join(strings.Fields(sb.String()), " "), nil
+}
+
+func attr(n *html.Node, key string) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val, true
+		}
+	}
+
+	return "", false
+}
+
+func setAttr(n *html.Node, key string, value string) {
+	for i, a := range n.Attr {
+		if a.Key == key {
+			n.Attr[i].Val = value
+			return
+		}
+	}
+	n.Attr = append(n.Attr, html.Attribute{Key: key, Val: value})
+}
+
+// isVisible учитывает только то, что видно по разметке: атрибут hidden и display:none у элемента и предков.
+func isVisible(n *html.Node) bool {
+	for ; n != nil; n = n.Parent {
+		if n.Type != html.ElementNode {
+			continue
+		}
+		if _, ok := attr(n, "hidden"); ok {
+			return false
+		}
+		if style, ok := attr(n, "style"); ok && strings.Contains(strings.ReplaceAll(style, " ", ""), "display:none") {
+			return false
+		}
+	}
+
+	return true
+}
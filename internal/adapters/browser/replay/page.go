@@ -0,0 +1,550 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/cascadia"
+	"github.com/go-rod/rod/lib/input"
+	"github.com/vo1dFl0w/market-parser/internal/adapters/browser/chromium"
+	"github.com/vo1dFl0w/market-parser/internal/adapters/browser/fixture"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+	"golang.org/x/net/html"
+)
+
+// defaultPagesNum совпадает с chromium: столько страниц парсится, если пагинации на странице нет
+const defaultPagesNum int = 5
+
+// productsURLPattern - часть url ответа с товарами, который перехватывает EachEvent
+const productsURLPattern = "products"
+
+// page воспроизводит вкладку. У адреса может быть несколько снимков html: поиск элемента, которого нет
+// в текущем снимке, переходит к первому следующему снимку с ним, как если бы страница дорисовалась после клика.
+type page struct {
+	fixture *fixture.Fixture
+	storage *storage
+
+	mu        sync.Mutex
+	url       string
+	snapshots []*html.Node
+	current   int
+	listeners []*listener
+}
+
+// listener - подписка EachEvent, срабатывает на следующий переход вкладки.
+type listener struct {
+	navigated chan string
+}
+
+func newPage(f *fixture.Fixture, s *storage) *page {
+	return &page{fixture: f, storage: s, snapshots: []*html.Node{emptyDocument()}}
+}
+
+func (p *page) CheckCaptcha(ctx context.Context, captchaCheckBox string, smartCaptchaSelector string) error {
+	b, _, err := p.Has(ctx, smartCaptchaSelector)
+	if err != nil {
+		return err
+	}
+	// капчу в записи не решить, парсинг такой сессии не воспроизводится
+	if b {
+		return fmt.Errorf("captcha recorded on %s: %w", p.currentURL(), domain.ErrCaptchaBlocked)
+	}
+
+	return nil
+}
+
+func (p *page) FindCategoryElement(ctx context.Context, categorySelector string) error {
+	if err := p.WaitVisible(ctx, categorySelector); err != nil {
+		return fmt.Errorf("wait visible category selector: %w", err)
+	}
+	categoryButton, err := p.Element(ctx, categorySelector)
+	if err != nil {
+		return fmt.Errorf("element category button: %w", err)
+	}
+	if err := categoryButton.Click(ctx); err != nil {
+		return fmt.Errorf("click category button: %w", err)
+	}
+
+	return nil
+}
+
+func (p *page) FindAllProductsBar(ctx context.Context, allProductsSelector string) error {
+	if err := p.WaitVisible(ctx, allProductsSelector); err != nil {
+		return fmt.Errorf("wait visible all products selector: %w", err)
+	}
+	allProdsBar, err := p.Element(ctx, allProductsSelector)
+	if err != nil {
+		return fmt.Errorf("element all prods bar: %w", err)
+	}
+	if err := allProdsBar.Click(ctx); err != nil {
+		return fmt.Errorf("click all prods bar: %w", err)
+	}
+
+	return nil
+}
+
+func (p *page) FindLastPageNum(ctx context.Context, lastPageSelector string, lastPageText string) (int, error) {
+	b, lastPageElem, err := p.Has(ctx, lastPageSelector)
+	if err != nil {
+		return 0, fmt.Errorf("has last page selector: %w", err)
+	}
+	if !b {
+		return defaultPagesNum, nil
+	}
+
+	textElem, err := lastPageElem.Element(ctx, lastPageText)
+	if err != nil {
+		return 0, fmt.Errorf("element last page text: %w", err)
+	}
+	lastPageStr, err := textElem.Text(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("text last page str: %w", err)
+	}
+	lastPageNum, err := strconv.Atoi(lastPageStr)
+	if err != nil {
+		return 0, fmt.Errorf("parse string to integer last page num: %w", err)
+	}
+
+	return lastPageNum, nil
+}
+
+func (p *page) FindAddressButton(ctx context.Context, addressButtonSelector string) error {
+	addressButton, err := p.Element(ctx, addressButtonSelector)
+	if err != nil {
+		return fmt.Errorf("element address button: %w", err)
+	}
+	if err := addressButton.Click(ctx); err != nil {
+		return fmt.Errorf("click address button: %w", err)
+	}
+
+	return nil
+}
+
+func (p *page) InputAddress(ctx context.Context, address string, addressInputSelector string) error {
+	addressInput, err := p.Element(ctx, addressInputSelector)
+	if err != nil {
+		return fmt.Errorf("element address input: %w", err)
+	}
+	if err := addressInput.Input(ctx, address); err != nil {
+		return fmt.Errorf("input address input: %w", err)
+	}
+
+	return nil
+}
+
+func (p *page) ClickDropDownAddress(ctx context.Context, addressInputDropDownSelector string) error {
+	addressDropDown, err := p.Element(ctx, addressInputDropDownSelector)
+	if err != nil {
+		return fmt.Errorf("element address drop down: %w", err)
+	}
+	if err := addressDropDown.Click(ctx); err != nil {
+		return fmt.Errorf("click address drop down: %w", err)
+	}
+
+	return nil
+}
+
+func (p *page) SaveDeliveryAddress(ctx context.Context, addressSaveButtonSelector string) error {
+	if err := p.WaitVisible(ctx, addressSaveButtonSelector); err != nil {
+		return fmt.Errorf("wait visible address save button selector: %w", err)
+	}
+	addressSave, err := p.Element(ctx, addressSaveButtonSelector)
+	if err != nil {
+		return fmt.Errorf("element address save: %w", err)
+	}
+	if err := addressSave.Click(ctx); err != nil {
+		return fmt.Errorf("click address save: %w", err)
+	}
+
+	return nil
+}
+
+// ParsePages обходит страницы так же, как chromium (basePageURL+&page=1,2,3...), но последовательно в одной вкладке.
+func (p *page) ParsePages(ctx context.Context, firstPageNum int, lastPageNum int) ([]domain.Products, error) {
+	basePageURL, err := p.GetPageURL(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get page url: %w", err)
+	}
+
+	hooks := domain.ParseHooksFromContext(ctx)
+	result := []domain.Products{}
+	pagesErr := &domain.PagesError{}
+	for i := firstPageNum; i <= lastPageNum; i++ {
+		targetURL := fmt.Sprintf("%s&page=%d", basePageURL, i)
+		res, err := p.parsePage(ctx, targetURL)
+		if err != nil {
+			pagesErr.Add(i, err)
+			continue
+		}
+		hooks.Page(i, res)
+		if !hooks.Streaming() {
+			result = append(result, res...)
+		}
+	}
+
+	if len(pagesErr.Pages) > 0 {
+		return result, pagesErr
+	}
+
+	return result, nil
+}
+
+func (p *page) parsePage(ctx context.Context, targetURL string) ([]domain.Products, error) {
+	result := []domain.Products{}
+	hooks := domain.ParseHooksFromContext(ctx)
+
+	resCh, errCh, stopListeningFn := p.EachEvent(ctx)
+	defer stopListeningFn()
+
+	if err := p.Navigate(ctx, targetURL); err != nil {
+		return nil, fmt.Errorf("navigate %s: %w", targetURL, err)
+	}
+
+	for {
+		select {
+		case r, ok := <-resCh:
+			if !ok {
+				if errCh != nil {
+					if err, ok := <-errCh; ok && err != nil {
+						return nil, err
+					}
+				}
+				return result, nil
+			}
+			hooks.Product(r)
+			result = append(result, r)
+		case err, ok := <-errCh:
+			if !ok {
+				errCh = nil
+				continue
+			}
+			return nil, err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// CaptureRequest не воспроизводится: запись хранит только тела ответов.
+func (p *page) CaptureRequest(ctx context.Context, urlPattern string, targetURL string) (*domain.CapturedRequest, error) {
+	return nil, fmt.Errorf("capture request: %w", errors.ErrUnsupported)
+}
+
+func (p *page) CaptureResponse(ctx context.Context, urlPattern string, targetURL string) (string, error) {
+	if err := p.Navigate(ctx, targetURL); err != nil {
+		return "", fmt.Errorf("navigate %s: %w", targetURL, err)
+	}
+
+	bodies, err := p.fixture.Responses(targetURL, urlPattern)
+	if err != nil {
+		return "", err
+	}
+	if len(bodies) == 0 {
+		return "", fmt.Errorf("response %s not recorded for %s", urlPattern, targetURL)
+	}
+
+	return bodies[0], nil
+}
+
+// Navigate открывает первый снимок адреса. Незаписанный адрес открывается пустой страницей:
+// у страниц пагинации записаны только ответы api.
+func (p *page) Navigate(ctx context.Context, targetURL string) error {
+	pages, err := p.fixture.Pages(targetURL)
+	if err != nil {
+		return err
+	}
+
+	snapshots := make([]*html.Node, 0, len(pages))
+	for _, raw := range pages {
+		doc, err := html.Parse(strings.NewReader(raw))
+		if err != nil {
+			return fmt.Errorf("parse html %s: %w", targetURL, err)
+		}
+		snapshots = append(snapshots, doc)
+	}
+	if len(snapshots) == 0 {
+		snapshots = append(snapshots, emptyDocument())
+	}
+
+	p.mu.Lock()
+	p.url = targetURL
+	p.snapshots = snapshots
+	p.current = 0
+	listeners := p.listeners
+	p.listeners = nil
+	p.mu.Unlock()
+
+	for _, l := range listeners {
+		l.navigated <- targetURL
+	}
+
+	return nil
+}
+
+func (p *page) NavigateWithReferrer(ctx context.Context, marketURL string) error {
+	return p.Navigate(ctx, marketURL)
+}
+
+// NewTab открывает вкладку той же сессии с общими cookies и localStorage.
+func (p *page) NewTab(ctx context.Context) (repository.Page, error) {
+	return newPage(p.fixture, p.storage), nil
+}
+
+func (p *page) Element(ctx context.Context, selector string) (repository.Element, error) {
+	node, err := p.wait(selector, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &element{page: p, node: node}, nil
+}
+
+func (p *page) Elements(ctx context.Context, selector string) ([]repository.Element, error) {
+	sel, err := cascadia.Compile(selector)
+	if err != nil {
+		return nil, fmt.Errorf("compile selector %s: %w", selector, err)
+	}
+	if _, err := p.wait(selector, false); err != nil {
+		return nil, err
+	}
+
+	nodes := sel.MatchAll(p.document())
+	res := make([]repository.Element, 0, len(nodes))
+	for _, node := range nodes {
+		res = append(res, &element{page: p, node: node})
+	}
+
+	return res, nil
+}
+
+// Has проверяет только текущий снимок, как и в chromium, где Has не ждёт появления элемента.
+func (p *page) Has(ctx context.Context, selector string) (bool, repository.Element, error) {
+	sel, err := cascadia.Compile(selector)
+	if err != nil {
+		return false, nil, fmt.Errorf("compile selector %s: %w", selector, err)
+	}
+
+	node := sel.MatchFirst(p.document())
+	if node == nil {
+		return false, nil, nil
+	}
+
+	return true, &element{page: p, node: node}, nil
+}
+
+func (p *page) HTML(ctx context.Context) (string, error) {
+	var sb strings.Builder
+	if err := html.Render(&sb, p.document()); err != nil {
+		return "", err
+	}
+
+	return sb.String(), nil
+}
+
+// EachEvent отдаёт товары первого записанного ответа products для адреса следующего перехода вкладки.
+// Если ответа нет, каналы закрываются пустыми, как chromium по истечении work_timeout.
+func (p *page) EachEvent(ctx context.Context) (<-chan domain.Products, <-chan error, func()) {
+	ctxEvent, cancel := context.WithCancel(ctx)
+
+	resCh := make(chan domain.Products, 100)
+	errCh := make(chan error, 1)
+	l := &listener{navigated: make(chan string, 1)}
+
+	p.mu.Lock()
+	p.listeners = append(p.listeners, l)
+	p.mu.Unlock()
+
+	go func() {
+		defer close(resCh)
+		defer close(errCh)
+
+		var targetURL string
+		select {
+		case targetURL = <-l.navigated:
+		case <-ctxEvent.Done():
+			return
+		}
+
+		bodies, err := p.fixture.Responses(targetURL, productsURLPattern)
+		if err != nil {
+			errCh <- err
+			return
+		}
+		for _, body := range bodies {
+			data := &chromium.ProductsResponse{}
+			if err := json.Unmarshal([]byte(body), data); err != nil {
+				continue
+			}
+			for _, prod := range data.Prods {
+				select {
+				case resCh <- prod.ToDomain():
+				case <-ctxEvent.Done():
+					return
+				}
+			}
+			return
+		}
+		// в записи нет ответа products, как если бы chromium не дождался его за WorkTimeout
+		errCh <- chromium.ErrNoProductsResponse
+	}()
+
+	stopListeningFn := func() {
+		cancel()
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		for i, other := range p.listeners {
+			if other == l {
+				p.listeners = append(p.listeners[:i], p.listeners[i+1:]...)
+				break
+			}
+		}
+	}
+
+	return resCh, errCh, stopListeningFn
+}
+
+func (p *page) GetPageURL(ctx context.Context) (string, error) {
+	return p.currentURL(), nil
+}
+
+func (p *page) currentURL() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.url
+}
+
+func (p *page) MoveCursorToElement(ctx context.Context, selector string) error {
+	_, err := p.wait(selector, false)
+	return err
+}
+
+func (p *page) KeyboardType(ctx context.Context, key ...input.Key) error {
+	return nil
+}
+
+func (p *page) Screenshot(ctx context.Context) ([]byte, error) {
+	return nil, fmt.Errorf("screenshot: %w", errors.ErrUnsupported)
+}
+
+func (p *page) Cookies(ctx context.Context) ([]domain.Cookie, error) {
+	p.storage.mu.Lock()
+	defer p.storage.mu.Unlock()
+
+	return append([]domain.Cookie{}, p.storage.cookies...), nil
+}
+
+func (p *page) SetCookies(ctx context.Context, cookies []domain.Cookie) error {
+	p.storage.mu.Lock()
+	defer p.storage.mu.Unlock()
+
+	p.storage.cookies = append(p.storage.cookies, cookies...)
+
+	return nil
+}
+
+func (p *page) LocalStorage(ctx context.Context) (map[string]string, error) {
+	p.storage.mu.Lock()
+	defer p.storage.mu.Unlock()
+
+	return maps.Clone(p.storage.localStorage), nil
+}
+
+func (p *page) SetLocalStorage(ctx context.Context, items map[string]string) error {
+	p.storage.mu.Lock()
+	defer p.storage.mu.Unlock()
+
+	maps.Copy(p.storage.localStorage, items)
+
+	return nil
+}
+
+func (p *page) WaitStable(ctx context.Context) error {
+	return nil
+}
+
+func (p *page) WaitLoad(ctx context.Context) error {
+	return nil
+}
+
+func (p *page) WaitDOMStable(ctx context.Context) error {
+	return nil
+}
+
+func (p *page) WaitVisible(ctx context.Context, selector string) error {
+	_, err := p.wait(selector, true)
+	return err
+}
+
+func (p *page) ClosePage() error {
+	return nil
+}
+
+func (p *page) CloseBrowser() error {
+	return nil
+}
+
+// wait ищет элемент в текущем снимке и, если его нет, в следующих снимках адреса.
+// Найденный снимок становится текущим.
+func (p *page) wait(selector string, visible bool) (*html.Node, error) {
+	sel, err := cascadia.Compile(selector)
+	if err != nil {
+		return nil, fmt.Errorf("compile selector %s: %w", selector, err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	hidden := false
+	for i := p.current; i < len(p.snapshots); i++ {
+		for _, node := range sel.MatchAll(p.snapshots[i]) {
+			if visible && !isVisible(node) {
+				hidden = true
+				continue
+			}
+			p.current = i
+			return node, nil
+		}
+	}
+
+	if hidden {
+		return nil, fmt.Errorf("element %s is not visible on %s", selector, p.url)
+	}
+
+	return nil, fmt.Errorf("element %s not recorded on %s", selector, p.url)
+}
+
+// emptyDocument - страница без записанного html (<html><head></head><body></body></html>).
+func emptyDocument() *html.Node {
+	doc, _ := html.Parse(strings.NewReader(""))
+	return doc
+}
+
+func (p *page) document() *html.Node {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.snapshots[p.current]
+}
+
+// followLink переходит по href ссылки, как браузер после клика по ней.
+func (p *page) followLink(ctx context.Context, href string) error {
+	base, err := url.Parse(p.currentURL())
+	if err != nil {
+		return fmt.Errorf("parse page url: %w", err)
+	}
+	ref, err := url.Parse(href)
+	if err != nil {
+		return fmt.Errorf("parse href %s: %w", href, err)
+	}
+
+	return p.Navigate(ctx, base.ResolveReference(ref).String())
+}
@@ -0,0 +1,110 @@
+package replay
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/vo1dFl0w/market-parser/internal/adapters/browser/fixture"
+)
+
+func newTestBrowser(t *testing.T) *Browser {
+	t.Helper()
+
+	dir := t.TempDir()
+	rec, err := fixture.NewRecorder(dir)
+	if err != nil {
+		t.Fatalf("new recorder: %v", err)
+	}
+	snapshots := []string{
+		`<button id="open">Адрес</button><a id="next" href="/metro?sid=1"><span>METRO</span></a>`,
+		`<div id="modal"><input id="address"><span id="save" hidden>Сохранить</span></div>`,
+		`<div id="modal"><span id="save">Сохранить</span></div>`,
+	}
+	for _, s := range snapshots {
+		if err := rec.RecordPage("https://kuper.ru", s); err != nil {
+			t.Fatalf("record page: %v", err)
+		}
+	}
+
+	b, err := NewBrowser(dir)
+	if err != nil {
+		t.Fatalf("new browser: %v", err)
+	}
+
+	return b
+}
+
+func TestPageSnapshots(t *testing.T) {
+	ctx := context.Background()
+	p, err := newTestBrowser(t).NewPage(ctx, "https://kuper.ru/")
+	if err != nil {
+		t.Fatalf("new page: %v", err)
+	}
+
+	// Has не ждёт следующих снимков
+	if b, _, err := p.Has(ctx, "#address"); err != nil || b {
+		t.Fatalf("has #address in first snapshot = %t, %v", b, err)
+	}
+
+	input, err := p.Element(ctx, "#address")
+	if err != nil {
+		t.Fatalf("element #address: %v", err)
+	}
+	if err := input.Input(ctx, "Москва"); err != nil {
+		t.Fatalf("input: %v", err)
+	}
+	if v, _ := input.Attribute(ctx, "value"); v != "Москва" {
+		t.Errorf("value = %q, want Москва", v)
+	}
+
+	// скрытая кнопка второго снимка пропускается, видимая есть в третьем
+	if err := p.WaitVisible(ctx, "#save"); err != nil {
+		t.Fatalf("wait visible #save: %v", err)
+	}
+	// к предыдущим снимкам страница не возвращается
+	if _, err := p.Element(ctx, "#open"); err == nil {
+		t.Error("element #open found after the page moved to a later snapshot")
+	}
+}
+
+func TestPageNotVisible(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBrowser(t)
+	p, err := b.NewPage(ctx, "https://kuper.ru")
+	if err != nil {
+		t.Fatalf("new page: %v", err)
+	}
+
+	if _, err := p.Element(ctx, "#address"); err != nil {
+		t.Fatalf("element #address: %v", err)
+	}
+	if err := p.WaitVisible(ctx, "#address + span[hidden]"); err == nil || !strings.Contains(err.Error(), "not visible") {
+		t.Errorf("wait visible hidden element: %v", err)
+	}
+}
+
+func TestElementClickFollowsLink(t *testing.T) {
+	ctx := context.Background()
+	p, err := newTestBrowser(t).NewPage(ctx, "https://kuper.ru")
+	if err != nil {
+		t.Fatalf("new page: %v", err)
+	}
+
+	span, err := p.Element(ctx, "#next span")
+	if err != nil {
+		t.Fatalf("element: %v", err)
+	}
+	if err := span.Click(ctx); err != nil {
+		t.Fatalf("click: %v", err)
+	}
+
+	got, _ := p.GetPageURL(ctx)
+	if got != "https://kuper.ru/metro?sid=1" {
+		t.Errorf("url after click = %q", got)
+	}
+	// адрес не записан, страница пустая
+	if b, _, _ := p.Has(ctx, "#open"); b {
+		t.Error("unrecorded page is not empty")
+	}
+}
@@ -0,0 +1,42 @@
+package notify
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+type logNotifier struct {
+	logger logger.Logger
+}
+
+// NewLogNotifier пишет оповещения в лог сервиса, по записи на событие.
+func NewLogNotifier(logger logger.Logger) *logNotifier {
+	return &logNotifier{logger: logger}
+}
+
+func (n *logNotifier) Notify(ctx context.Context, rule *domain.AlertRule, events []domain.AlertEvent) error {
+	for _, e := range events {
+		attrs := []any{
+			"rule_id", rule.ID,
+			"rule", rule.Name,
+			"kind", rule.Kind,
+			"market", e.Market,
+			"address", e.Address,
+			"category", e.Category,
+			"product", e.Product.Name,
+			"url", e.Product.URL,
+			"price", e.NewPrice,
+		}
+		if e.OldPrice != nil {
+			attrs = append(attrs, "old_price", *e.OldPrice)
+		}
+		if e.ChangePercent != nil {
+			attrs = append(attrs, "change_percent", *e.ChangePercent)
+		}
+		n.logger.Info("alert fired", attrs...)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package notify
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+// maxMessageEvents ограничивает текст оповещения: telegram не принимает сообщения длиннее 4096 символов.
+const maxMessageEvents = 20
+
+// message собирает текстовое оповещение для telegram и лога.
+func message(rule *domain.AlertRule, events []domain.AlertEvent) string {
+	b := strings.Builder{}
+	fmt.Fprintf(&b, "Alert %q (%s %g)\n", rule.Name, rule.Kind, rule.Threshold)
+
+	for i, e := range events {
+		if i == maxMessageEvents {
+			fmt.Fprintf(&b, "\n...and %d more", len(events)-maxMessageEvents)
+			break
+		}
+		b.WriteString("\n")
+		b.WriteString(eventLine(e))
+	}
+
+	return b.String()
+}
+
+func eventLine(e domain.AlertEvent) string {
+	price := fmt.Sprintf("%.2f", e.NewPrice)
+	if e.OldPrice != nil {
+		price = fmt.Sprintf("%.2f -> %.2f", *e.OldPrice, e.NewPrice)
+	}
+	if e.ChangePercent != nil {
+		price += fmt.Sprintf(" (%+.2f%%)", *e.ChangePercent)
+	}
+
+	return fmt.Sprintf("%s: %s\n%s, %s, %s\n%s", e.Product.Name, price, e.Market, e.Address, e.Category, e.Product.URL)
+}
@@ -0,0 +1,69 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+type telegramNotifier struct {
+	client  *http.Client
+	baseURL string
+	token   string
+}
+
+// NewTelegramNotifier отправляет оповещения через Bot API в чат rule.Target.
+// baseURL позволяет ходить через прокси или собственный Bot API сервер.
+func NewTelegramNotifier(baseURL string, token string, timeout time.Duration) *telegramNotifier {
+	return &telegramNotifier{
+		client:  &http.Client{Timeout: timeout},
+		baseURL: strings.TrimRight(baseURL, "/"),
+		token:   token,
+	}
+}
+
+type sendMessageRequest struct {
+	ChatID                string `json:"chat_id"`
+	Text                  string `json:"text"`
+	DisableWebPagePreview bool   `json:"disable_web_page_preview"`
+}
+
+func (n *telegramNotifier) Notify(ctx context.Context, rule *domain.AlertRule, events []domain.AlertEvent) error {
+	if n.token == "" {
+		return errors.New("telegram token is empty")
+	}
+
+	body, err := json.Marshal(sendMessageRequest{ChatID: rule.Target, Text: message(rule, events), DisableWebPagePreview: true})
+	if err != nil {
+		return fmt.Errorf("marshal message: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/bot%s/sendMessage", n.baseURL, n.token)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("new request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		// ошибка содержит url с токеном бота
+		return errors.New("do request: telegram unreachable")
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/adapters/webhook"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+type webhookNotifier struct {
+	client *http.Client
+	secret []byte
+}
+
+// NewWebhookNotifier отправляет оповещения POST-запросом на rule.Target. Тело подписывается
+// так же, как доставки результатов задач, см. webhook.NewSender.
+func NewWebhookNotifier(secret string, timeout time.Duration) *webhookNotifier {
+	return &webhookNotifier{
+		client: &http.Client{Timeout: timeout},
+		secret: []byte(secret),
+	}
+}
+
+type alertPayload struct {
+	RuleID    string          `json:"rule_id"`
+	RuleName  string          `json:"rule_name"`
+	Kind      string          `json:"kind"`
+	Threshold float64         `json:"threshold"`
+	Events    []alertEventDTO `json:"events"`
+}
+
+type alertEventDTO struct {
+	SnapshotID    string    `json:"snapshot_id"`
+	Market        string    `json:"market"`
+	Address       string    `json:"address"`
+	Category      string    `json:"category"`
+	Name          string    `json:"name"`
+	Link          string    `json:"link"`
+	SKU           *string   `json:"sku,omitempty"`
+	OldPrice      *float64  `json:"old_price,omitempty"`
+	NewPrice      float64   `json:"new_price"`
+	ChangePercent *float64  `json:"change_percent,omitempty"`
+	At            time.Time `json:"at"`
+}
+
+func (n *webhookNotifier) Notify(ctx context.Context, rule *domain.AlertRule, events []domain.AlertEvent) error {
+	payload := alertPayload{
+		RuleID:    rule.ID,
+		RuleName:  rule.Name,
+		Kind:      string(rule.Kind),
+		Threshold: rule.Threshold,
+		Events:    make([]alertEventDTO, 0, len(events)),
+	}
+	for _, e := range events {
+		payload.Events = append(payload.Events, alertEventDTO{
+			SnapshotID:    e.SnapshotID,
+			Market:        e.Market,
+			Address:       e.Address,
+			Category:      e.Category,
+			Name:          e.Product.Name,
+			Link:          e.Product.URL,
+			SKU:           e.Product.SKU,
+			OldPrice:      e.OldPrice,
+			NewPrice:      e.NewPrice,
+			ChangePercent: e.ChangePercent,
+			At:            e.At,
+		})
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rule.Target, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("new request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhook.HeaderTimestamp, timestamp)
+	req.Header.Set(webhook.HeaderSignature, "sha256="+webhook.Sign(n.secret, timestamp, body))
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
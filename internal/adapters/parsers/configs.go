@@ -1,16 +1,33 @@
 package parsers
 
 import (
+	"net"
+	"net/url"
+	"time"
+
 	"github.com/vo1dFl0w/market-parser/internal/config"
 )
 
 type KuperConfig struct {
-	TestParserMode  bool
-	HumanLikeMode   bool
-	ApiProductsPath string
-	BaseURL         string
-	Referrer        string
-	Selectors       *KuperSelectors
+	TestParserMode    bool
+	HumanLikeMode     bool
+	ApiProductsPath   string
+	ApiCategoriesPath string
+	BaseURL           string
+	Referrer          string
+	ApiTimeout        time.Duration
+	ApiProxy          *url.URL
+	Search            *KuperSearch
+	Selectors         *KuperSelectors
+}
+
+type KuperSearch struct {
+	Path          string
+	QueryParam    string
+	SortParam     string
+	SortValues    map[string]string
+	PriceMinParam string
+	PriceMaxParam string
 }
 
 type KuperSelectors struct {
@@ -23,6 +40,9 @@ type KuperSelectors struct {
 	AddressInputDropDownSelector string
 	AddressSaveButtonSelector    string
 	MarketSelector               string
+	MarketCardSelector           string
+	MarketLogoSelector           string
+	MarketDeliverySelector       string
 	AllProdsSelector             string
 	LastPageSelector             string
 	LastPageText                 string
@@ -31,24 +51,53 @@ type KuperSelectors struct {
 
 func NewKuperConfig(cfg *config.Config) *KuperConfig {
 	return &KuperConfig{
-		TestParserMode:  cfg.Browser.TestParserMode,
-		HumanLikeMode:   cfg.Browser.HumanLikeMode,
-		ApiProductsPath: *cfg.Server.KuperCfg.ApiProductsPath,
-		BaseURL:         *cfg.Server.KuperCfg.BaseURL,
-		Referrer:        cfg.Browser.Referer,
+		TestParserMode:    cfg.Browser.TestParserMode,
+		HumanLikeMode:     cfg.Browser.HumanLikeMode,
+		ApiProductsPath:   *cfg.Providers.Kuper.ApiProductsPath,
+		ApiCategoriesPath: cfg.Providers.Kuper.ApiCategoriesPath,
+		BaseURL:           *cfg.Providers.Kuper.BaseURL,
+		Referrer:          cfg.Browser.Referer,
+		ApiTimeout:        cfg.Providers.Kuper.ApiTimeout,
+		ApiProxy:          proxyURL(cfg.Browser.Proxy),
+		Search: &KuperSearch{
+			Path:          cfg.Providers.Kuper.Search.Path,
+			QueryParam:    cfg.Providers.Kuper.Search.QueryParam,
+			SortParam:     cfg.Providers.Kuper.Search.SortParam,
+			SortValues:    cfg.Providers.Kuper.Search.SortValues,
+			PriceMinParam: cfg.Providers.Kuper.Search.PriceMinParam,
+			PriceMaxParam: cfg.Providers.Kuper.Search.PriceMaxParam,
+		},
 		Selectors: &KuperSelectors{
-			SmartCaptchaSelector:         *cfg.Server.KuperCfg.SmartCaptchaSelector,
-			CurrentAddressSelector:       *cfg.Server.KuperCfg.CurrentAddressSelector,
-			AddressButtonSelector:        *cfg.Server.KuperCfg.AddressButtonSelector,
-			AddressCheckAttributeValue:   *cfg.Server.KuperCfg.AddressCheckAttributeValue,
-			AddressInputSelector:         *cfg.Server.KuperCfg.AddressInputSelector,
-			AddressInputDropDownSelector: *cfg.Server.KuperCfg.AddressInputDropDownSelector,
-			AddressSaveButtonSelector:    *cfg.Server.KuperCfg.AddressSaveButtonSelector,
-			MarketSelector:               *cfg.Server.KuperCfg.MarketSelector,
-			AllProdsSelector:             *cfg.Server.KuperCfg.AllProdsSelector,
-			LastPageSelector:             *cfg.Server.KuperCfg.LastPageSelector,
-			LastPageText:                 *cfg.Server.KuperCfg.LastPageText,
-			NextPageSelector:             *cfg.Server.KuperCfg.NextPageSelector,
+			SmartCaptchaSelector:         *cfg.Providers.Kuper.SmartCaptchaSelector,
+			CurrentAddressSelector:       *cfg.Providers.Kuper.CurrentAddressSelector,
+			AddressButtonSelector:        *cfg.Providers.Kuper.AddressButtonSelector,
+			AddressCheckAttributeValue:   *cfg.Providers.Kuper.AddressCheckAttributeValue,
+			AddressInputSelector:         *cfg.Providers.Kuper.AddressInputSelector,
+			AddressInputDropDownSelector: *cfg.Providers.Kuper.AddressInputDropDownSelector,
+			AddressSaveButtonSelector:    *cfg.Providers.Kuper.AddressSaveButtonSelector,
+			MarketSelector:               *cfg.Providers.Kuper.MarketSelector,
+			MarketCardSelector:           *cfg.Providers.Kuper.MarketCardSelector,
+			MarketLogoSelector:           *cfg.Providers.Kuper.MarketLogoSelector,
+			MarketDeliverySelector:       *cfg.Providers.Kuper.MarketDeliverySelector,
+			AllProdsSelector:             *cfg.Providers.Kuper.AllProdsSelector,
+			LastPageSelector:             *cfg.Providers.Kuper.LastPageSelector,
+			LastPageText:                 *cfg.Providers.Kuper.LastPageText,
+			NextPageSelector:             *cfg.Providers.Kuper.NextPageSelector,
 		},
 	}
 }
+
+// proxyURL собирает адрес прокси браузера вместе с логином и паролем. Прокси задан, когда указаны ip и порт,
+// так же как при запуске Chromium.
+func proxyURL(p config.ProxyConfig) *url.URL {
+	if p.IP == "" || p.Port == "" {
+		return nil
+	}
+
+	u := &url.URL{Scheme: "http", Host: net.JoinHostPort(p.IP, p.Port)}
+	if p.Login != "" {
+		u.User = url.UserPassword(p.Login, p.Password)
+	}
+
+	return u
+}
@@ -2,8 +2,10 @@ package parsers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/vo1dFl0w/market-parser/internal/config"
 	"github.com/vo1dFl0w/market-parser/internal/domain"
@@ -18,20 +20,113 @@ type Kuper interface {
 }
 
 type kuper struct {
-	cfg     *KuperConfig
-	browser repository.BrowserRepository
-	logger  logger.Logger
+	cfg      *KuperConfig
+	browser  repository.BrowserRepository
+	sessions repository.SessionRepository
+	logger   logger.Logger
 }
 
-func NewKuperParser(cfg *config.Config, logger logger.Logger, browser repository.BrowserRepository) *kuper {
+// NewKuperParser создаёт парсер kuper. sessions может быть nil, тогда адрес доставки вводится при каждом запросе.
+func NewKuperParser(cfg *config.Config, logger logger.Logger, browser repository.BrowserRepository, sessions repository.SessionRepository) *kuper {
 	return &kuper{
-		cfg:     NewKuperConfig(cfg),
-		browser: browser,
-		logger:  logger,
+		cfg:      NewKuperConfig(cfg),
+		browser:  browser,
+		sessions: sessions,
+		logger:   logger,
 	}
 }
 
 func (kp *kuper) GetAllProductsByCategory(ctx context.Context, category string, address string, market string) ([]domain.Products, error) {
+	page, err := kp.openSession(ctx, address, market)
+	if err != nil {
+		return nil, err
+	}
+	defer page.CloseBrowser()
+	defer page.ClosePage()
+
+	lastPageNum, err := kp.openCategory(ctx, page, category)
+	if err != nil {
+		return nil, err
+	}
+
+	// при ошибке части страниц возвращаются товары разобранных страниц
+	res, err := page.ParsePages(ctx, 1, lastPageNum)
+	if err != nil {
+		return res, fmt.Errorf("parse pages: %w", err)
+	}
+
+	return res, nil
+}
+
+func (kp *kuper) GetAllProductsByCategoryForMarkets(ctx context.Context, category string, address string, markets []string) ([]domain.MarketProducts, error) {
+	return kp.fanOut(ctx, address, markets, func(ctx context.Context, page repository.Page, market string) ([]domain.Products, error) {
+		lastPageNum, err := kp.openCategory(ctx, page, category)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := page.ParsePages(ctx, 1, lastPageNum)
+		if err != nil {
+			return res, fmt.Errorf("parse pages: %w", err)
+		}
+
+		return res, nil
+	})
+}
+
+// fanOut устанавливает адрес доставки один раз на главной странице и параллельно открывает
+// каждый market в отдельной вкладке той же сессии браузера. Ошибка одного market не прерывает остальные.
+func (kp *kuper) fanOut(ctx context.Context, address string, markets []string, parseFn func(ctx context.Context, page repository.Page, market string) ([]domain.Products, error)) ([]domain.MarketProducts, error) {
+	page, err := kp.openSession(ctx, address, "")
+	if err != nil {
+		return nil, err
+	}
+	defer page.CloseBrowser()
+	defer page.ClosePage()
+
+	res := make([]domain.MarketProducts, len(markets))
+	wg := &sync.WaitGroup{}
+
+	for i, market := range markets {
+		res[i].Market = market
+
+		wg.Add(1)
+		go func(mp *domain.MarketProducts) {
+			defer wg.Done()
+
+			// вкладка делит сессию с page, поэтому закрывается только сама вкладка
+			tab, err := page.NewTab(ctx)
+			if err != nil {
+				mp.Err = fmt.Errorf("new tab: %w", err)
+				return
+			}
+			defer tab.ClosePage()
+
+			if err := kp.openMarket(ctx, tab, mp.Market); err != nil {
+				mp.Err = err
+				return
+			}
+			changed, err := kp.setDeliveryAddress(ctx, tab, address)
+			if err != nil {
+				mp.Err = err
+				return
+			}
+			if changed {
+				kp.saveSession(ctx, tab, address, mp.Market)
+			}
+
+			mp.Products, mp.Err = parseFn(ctx, tab, mp.Market)
+		}(&res[i])
+	}
+	wg.Wait()
+
+	return res, nil
+}
+
+// openSession открывает kuper.ru, переходит на страницу market и устанавливает адрес доставки.
+// При пустом market адрес устанавливается на главной странице.
+// Закрытие страницы и браузера остаётся за вызывающим.
+func (kp *kuper) openSession(ctx context.Context, address string, market string) (repository.Page, error) {
 	selector := kp.cfg.Selectors
 
 	// создание и переход на сайт kuper.ru
@@ -39,113 +134,203 @@ func (kp *kuper) GetAllProductsByCategory(ctx context.Context, category string,
 	if err != nil {
 		return nil, fmt.Errorf("new page: %w", err)
 	}
-	defer page.CloseBrowser()
-	defer page.ClosePage()
+
+	closeFn := func() {
+		page.ClosePage()
+		page.CloseBrowser()
+	}
 
 	if err := page.WaitLoad(ctx); err != nil {
+		closeFn()
 		return nil, fmt.Errorf("wait dom stable: %w", err)
 	}
 	if err := page.WaitStable(ctx); err != nil {
+		closeFn()
 		return nil, fmt.Errorf("wait dom stable: %w", err)
 	}
-	if err := page.CheckCaptcha(ctx, kp.cfg.Selectors.CaptchaCheckBox, kp.cfg.Selectors.SmartCaptchaSelector); err != nil {
+	if err := page.CheckCaptcha(ctx, selector.CaptchaCheckBox, selector.SmartCaptchaSelector); err != nil {
+		closeFn()
 		return nil, fmt.Errorf("check captcha: %w", err)
 	}
 
-	// переходим по url к заданному market
-	marketPageURL := fmt.Sprintf("%s/%s", kp.cfg.BaseURL, market)
-	if err := page.Navigate(ctx, marketPageURL); err != nil {
-		return nil, fmt.Errorf("navigate with referrer %s: %w", marketPageURL, err)
+	// восстанавливаем сохранённую сессию с адресом доставки до перехода на страницу market
+	restored := kp.restoreSession(ctx, page, address, market)
+
+	switch {
+	case market != "":
+		if err := kp.openMarket(ctx, page, market); err != nil {
+			closeFn()
+			return nil, err
+		}
+	case restored:
+		// главная страница загружена до восстановления сессии, перезагружаем её,
+		// чтобы сайт подхватил сохранённый адрес
+		if err := kp.openURL(ctx, page, kp.cfg.BaseURL); err != nil {
+			closeFn()
+			return nil, err
+		}
+	}
+
+	changed, err := kp.setDeliveryAddress(ctx, page, address)
+	if err != nil {
+		closeFn()
+		return nil, err
+	}
+	if changed {
+		kp.saveSession(ctx, page, address, market)
+	}
+
+	return page, nil
+}
+
+// openMarket переходит по url к заданному market.
+func (kp *kuper) openMarket(ctx context.Context, page repository.Page, market string) error {
+	return kp.openURL(ctx, page, fmt.Sprintf("%s/%s", kp.cfg.BaseURL, market))
+}
+
+// openURL переходит на targetURL и дожидается загрузки страницы.
+func (kp *kuper) openURL(ctx context.Context, page repository.Page, targetURL string) error {
+	selector := kp.cfg.Selectors
+
+	if err := page.Navigate(ctx, targetURL); err != nil {
+		return fmt.Errorf("navigate %s: %w", targetURL, err)
 	}
 	if err := page.WaitLoad(ctx); err != nil {
-		return nil, fmt.Errorf("wait dom stable: %w", err)
+		return fmt.Errorf("wait dom stable: %w", err)
 	}
-	if err := page.CheckCaptcha(ctx, kp.cfg.Selectors.CaptchaCheckBox, kp.cfg.Selectors.SmartCaptchaSelector); err != nil {
-		return nil, fmt.Errorf("check captcha: %w", err)
+	if err := page.CheckCaptcha(ctx, selector.CaptchaCheckBox, selector.SmartCaptchaSelector); err != nil {
+		return fmt.Errorf("check captcha: %w", err)
+	}
+
+	return nil
+}
+
+// restoreSession подставляет cookies и localStorage сохранённой сессии для (market, address).
+// Ошибки хранилища не прерывают парсинг: адрес в этом случае вводится заново.
+// Возвращает true, если сессия восстановлена и страницу нужно загрузить заново.
+func (kp *kuper) restoreSession(ctx context.Context, page repository.Page, address string, market string) bool {
+	if kp.sessions == nil {
+		return false
+	}
+
+	session, err := kp.sessions.GetSession(ctx, market, address)
+	if err != nil {
+		if !errors.Is(err, domain.ErrSessionNotFound) {
+			kp.logger.Warn("get address session", "market", market, "error", err)
+		}
+		return false
+	}
+
+	if err := page.SetCookies(ctx, session.Cookies); err != nil {
+		kp.logger.Warn("restore session cookies", "market", market, "error", err)
+		return false
+	}
+	if err := page.SetLocalStorage(ctx, session.LocalStorage); err != nil {
+		kp.logger.Warn("restore session local storage", "market", market, "error", err)
+	}
+
+	return true
+}
+
+// saveSession сохраняет cookies и localStorage после успешной установки адреса доставки.
+func (kp *kuper) saveSession(ctx context.Context, page repository.Page, address string, market string) {
+	if kp.sessions == nil {
+		return
 	}
 
+	cookies, err := page.Cookies(ctx)
+	if err != nil {
+		kp.logger.Warn("session cookies", "market", market, "error", err)
+		return
+	}
+	localStorage, err := page.LocalStorage(ctx)
+	if err != nil {
+		kp.logger.Warn("session local storage", "market", market, "error", err)
+		return
+	}
+
+	session := &domain.AddressSession{
+		Market:       market,
+		Address:      address,
+		Cookies:      cookies,
+		LocalStorage: localStorage,
+	}
+	if err := kp.sessions.SaveSession(ctx, session); err != nil {
+		kp.logger.Warn("save address session", "market", market, "error", err)
+	}
+}
+
+// setDeliveryAddress устанавливает адрес доставки, если на сайте указан другой адрес или он не задан вовсе.
+// Возвращает true, если адрес пришлось вводить.
+func (kp *kuper) setDeliveryAddress(ctx context.Context, page repository.Page, address string) (bool, error) {
+	selector := kp.cfg.Selectors
+
 	// проверяем установлен ли уже адрес на сайте
 	b, currentAddrBar, err := page.Has(ctx, selector.CurrentAddressSelector)
 	if err != nil {
-		return nil, fmt.Errorf("current addr bar: %w", err)
+		return false, fmt.Errorf("current addr bar: %w", err)
 	}
 	// если да, то смотрим содержимое адреса
 	if b {
 		addrText, err := currentAddrBar.Text(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("text addr text: %w", err)
+			return false, fmt.Errorf("text addr text: %w", err)
 		}
-		// если адрес не совпадает, то пытаемся его поменять, если нет, то пропускаем
-		if !strings.Contains(addrText, address) {
-			// нажать на кнопку для задания адреса доставки address
-			// адрес не установлен, пытаемся внести
-			if err := page.FindAddressButton(ctx, selector.AddressButtonSelector); err != nil {
-				return nil, fmt.Errorf("find address button: %w", err)
-			}
+		// если адрес совпадает, то пропускаем
+		if strings.Contains(addrText, address) {
+			return false, nil
+		}
+	}
 
-			// ввести адрес
-			if err := page.InputAddress(ctx, address, selector.AddressInputSelector); err != nil {
-				return nil, fmt.Errorf("input address: %w", err)
-			}
+	// нажать на кнопку для задания адреса доставки address
+	if err := page.FindAddressButton(ctx, selector.AddressButtonSelector); err != nil {
+		return false, fmt.Errorf("find address button: %w", err)
+	}
 
-			// нажать на вспылвший адрес
-			if err := page.ClickDropDownAddress(ctx, selector.AddressInputDropDownSelector); err != nil {
-				return nil, fmt.Errorf("click drop down address: %w", err)
-			}
+	// ввести адрес
+	if err := page.InputAddress(ctx, address, selector.AddressInputSelector); err != nil {
+		return false, fmt.Errorf("input address: %w", err)
+	}
 
-			// сохранить адрес
-			if err := page.SaveDeliveryAddress(ctx, kp.cfg.Selectors.AddressSaveButtonSelector); err != nil {
-				return nil, fmt.Errorf("save delivery address: %w", err)
-			}
-		}
-	} else {
-		// адрес не установлен, пытаемся внести
-		if err := page.FindAddressButton(ctx, selector.AddressButtonSelector); err != nil {
-			return nil, fmt.Errorf("find address button: %w", err)
-		}
+	// нажать на вспылвший адрес
+	if err := page.ClickDropDownAddress(ctx, selector.AddressInputDropDownSelector); err != nil {
+		return false, fmt.Errorf("click drop down address: %w", err)
+	}
 
-		// ввести адрес
-		if err := page.InputAddress(ctx, address, selector.AddressInputSelector); err != nil {
-			return nil, fmt.Errorf("input address: %w", err)
-		}
+	// сохранить адрес
+	if err := page.SaveDeliveryAddress(ctx, selector.AddressSaveButtonSelector); err != nil {
+		return false, fmt.Errorf("save delivery address: %w", err)
+	}
 
-		// нажать на вспылвший адрес
-		if err := page.ClickDropDownAddress(ctx, selector.AddressInputDropDownSelector); err != nil {
-			return nil, fmt.Errorf("click drop down address: %w", err)
-		}
+	return true, nil
+}
 
-		// сохранить адрес
-		if err := page.SaveDeliveryAddress(ctx, kp.cfg.Selectors.AddressSaveButtonSelector); err != nil {
-			return nil, fmt.Errorf("save delivery address: %w", err)
-		}
-	}
+// openCategory переходит к списку всех товаров категории и возвращает номер последней страницы.
+func (kp *kuper) openCategory(ctx context.Context, page repository.Page, category string) (int, error) {
+	selector := kp.cfg.Selectors
 
 	// находим селектор с категорией
 	categorySelector := fmt.Sprintf("span[title='%s']", category)
 	if err := page.FindCategoryElement(ctx, categorySelector); err != nil {
-		return nil, fmt.Errorf("find category element")
+		return 0, fmt.Errorf("find category element: %w", err)
 	}
 
 	//  находим строку "все товары категории"
 	if err := page.FindAllProductsBar(ctx, selector.AllProdsSelector); err != nil {
-		return nil, fmt.Errorf("find all products bar: %w", err)
+		return 0, fmt.Errorf("find all products bar: %w", err)
 	}
 
 	// ищем значение последней страницы
-	lastPageNum, err := page.FindLastPageNum(ctx, kp.cfg.Selectors.LastPageSelector, kp.cfg.Selectors.LastPageText)
+	lastPageNum, err := page.FindLastPageNum(ctx, selector.LastPageSelector, selector.LastPageText)
 	if err != nil {
-		return nil, fmt.Errorf("find last page num: %w", err)
+		return 0, fmt.Errorf("find last page num: %w", err)
 	}
 
 	// если test parser mode, то lastPageNum=testDefaultLastPageNum для тестирования функционала
 	if kp.cfg.TestParserMode {
 		lastPageNum = testDefaultLastPageNum
 	}
+	domain.ParseHooksFromContext(ctx).Pages(lastPageNum)
 
-	res, err := page.ParsePages(ctx, lastPageNum)
-	if err != nil {
-		return nil, fmt.Errorf("parse pages: %w", err)
-	}
-
-	return res, nil
+	return lastPageNum, nil
 }
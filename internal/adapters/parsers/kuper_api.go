@@ -0,0 +1,245 @@
+package parsers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/vo1dFl0w/market-parser/internal/adapters/browser/chromium"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+type kuperAPI struct {
+	kuper  *kuper
+	client *http.Client
+	logger logger.Logger
+}
+
+// NewKuperAPIParser использует браузер kuper только для получения cookies и сессии магазина/адреса,
+// а страницы с товарами запрашивает напрямую через api. Запросы идут через прокси браузера: cookies сессии,
+// полученные с ip прокси, с другого ip вызывают проверку на робота.
+func NewKuperAPIParser(logger logger.Logger, kuper *kuper) *kuperAPI {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if kuper.cfg.ApiProxy != nil {
+		transport.Proxy = http.ProxyURL(kuper.cfg.ApiProxy)
+	}
+
+	return &kuperAPI{
+		kuper:  kuper,
+		client: &http.Client{Timeout: kuper.cfg.ApiTimeout, Transport: transport},
+		logger: logger,
+	}
+}
+
+func (ka *kuperAPI) GetAllProductsByCategory(ctx context.Context, category string, address string, market string) ([]domain.Products, error) {
+	page, err := ka.kuper.openSession(ctx, address, market)
+	if err != nil {
+		return nil, fmt.Errorf("bootstrap api session: %w", err)
+	}
+	defer page.CloseBrowser()
+	defer page.ClosePage()
+
+	req, lastPageNum, err := ka.captureProductsRequest(ctx, page, category)
+	if err != nil {
+		return nil, fmt.Errorf("bootstrap api session: %w", err)
+	}
+
+	return ka.fetchPagesWithFallback(ctx, page, req, lastPageNum, "market", market, "category", category)
+}
+
+func (ka *kuperAPI) GetAllProductsByCategoryForMarkets(ctx context.Context, category string, address string, markets []string) ([]domain.MarketProducts, error) {
+	return ka.kuper.fanOut(ctx, address, markets, func(ctx context.Context, page repository.Page, market string) ([]domain.Products, error) {
+		req, lastPageNum, err := ka.captureProductsRequest(ctx, page, category)
+		if err != nil {
+			return nil, fmt.Errorf("bootstrap api session: %w", err)
+		}
+
+		return ka.fetchPagesWithFallback(ctx, page, req, lastPageNum, "market", market, "category", category)
+	})
+}
+
+// captureProductsRequest открывает категорию и перехватывает запрос к api товаров первой страницы.
+// После перехвата страница возвращается к списку товаров категории.
+func (ka *kuperAPI) captureProductsRequest(ctx context.Context, page repository.Page, category string) (*domain.CapturedRequest, int, error) {
+	lastPageNum, err := ka.kuper.openCategory(ctx, page, category)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	req, err := ka.capturePageRequest(ctx, page)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return req, lastPageNum, nil
+}
+
+// capturePageRequest перехватывает запрос к api товаров первой страницы открытого списка
+// и возвращает страницу к исходному url.
+func (ka *kuperAPI) capturePageRequest(ctx context.Context, page repository.Page) (*domain.CapturedRequest, error) {
+	basePageURL, err := page.GetPageURL(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get page url: %w", err)
+	}
+
+	targetURL := fmt.Sprintf("%s&page=%d", basePageURL, 1)
+	req, err := page.CaptureRequest(ctx, ka.kuper.cfg.ApiProductsPath, targetURL)
+	if err != nil {
+		return nil, fmt.Errorf("capture request: %w", err)
+	}
+
+	if err := page.Navigate(ctx, basePageURL); err != nil {
+		return nil, fmt.Errorf("navigate %s: %w", basePageURL, err)
+	}
+
+	return req, nil
+}
+
+func (ka *kuperAPI) SearchProducts(ctx context.Context, filter domain.SearchFilter, address string, market string) ([]domain.Products, error) {
+	page, err := ka.kuper.openSession(ctx, address, market)
+	if err != nil {
+		return nil, fmt.Errorf("bootstrap api session: %w", err)
+	}
+	defer page.CloseBrowser()
+	defer page.ClosePage()
+
+	lastPageNum, err := ka.kuper.openSearch(ctx, page, filter, market)
+	if err != nil {
+		return nil, fmt.Errorf("bootstrap api session: %w", err)
+	}
+
+	req, err := ka.capturePageRequest(ctx, page)
+	if err != nil {
+		return nil, fmt.Errorf("bootstrap api session: %w", err)
+	}
+
+	return ka.fetchPagesWithFallback(ctx, page, req, lastPageNum, "market", market, "query", filter.Query)
+}
+
+// fetchPagesWithFallback запрашивает страницы через api. Если api ответил проверкой на робота, страница
+// списка ещё открыта: браузер продолжает с первой не полученной страницы, не проходя заново сценарий
+// магазина, адреса и категории. Уже полученные страницы повторно не разбираются и не передаются в хуки.
+func (ka *kuperAPI) fetchPagesWithFallback(ctx context.Context, page repository.Page, req *domain.CapturedRequest, lastPageNum int, logAttrs ...any) ([]domain.Products, error) {
+	res, nextPageNum, err := ka.fetchPages(ctx, req, lastPageNum)
+	if err == nil {
+		return res, nil
+	}
+	if !errors.Is(err, domain.ErrAPIChallenge) {
+		return nil, fmt.Errorf("fetch pages: %w", err)
+	}
+
+	ka.logger.Warn("kuper api challenge, fallback to browser", append(logAttrs, "from_page", nextPageNum, "error", err)...)
+	rest, err := page.ParsePages(ctx, nextPageNum, lastPageNum)
+	res = append(res, rest...)
+	if err != nil {
+		return res, fmt.Errorf("parse pages: %w", err)
+	}
+
+	return res, nil
+}
+
+// fetchPages запрашивает страницы через api по порядку. При ошибке возвращает товары уже полученных
+// страниц и номер страницы, на которой остановился.
+func (ka *kuperAPI) fetchPages(ctx context.Context, req *domain.CapturedRequest, lastPageNum int) ([]domain.Products, int, error) {
+	result := []domain.Products{}
+	hooks := domain.ParseHooksFromContext(ctx)
+
+	for i := 1; i <= lastPageNum; i++ {
+		prods, err := ka.fetchPage(ctx, req, i)
+		if err != nil {
+			return result, i, fmt.Errorf("page %d: %w", i, err)
+		}
+		// пустая страница - товары в категории закончились
+		if len(prods) == 0 {
+			break
+		}
+		for _, p := range prods {
+			hooks.Product(p)
+		}
+		hooks.Page(i, prods)
+		if !hooks.Streaming() {
+			result = append(result, prods...)
+		}
+	}
+
+	return result, lastPageNum + 1, nil
+}
+
+func (ka *kuperAPI) fetchPage(ctx context.Context, captured *domain.CapturedRequest, pageNum int) ([]domain.Products, error) {
+	pageURL, err := url.Parse(captured.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parse url: %w", err)
+	}
+	q := pageURL.Query()
+	q.Set("page", strconv.Itoa(pageNum))
+	pageURL.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("new request: %w", err)
+	}
+	for k, v := range captured.Headers {
+		// сжатие ответа оставляем на net/http, иначе тело придёт не распакованным
+		if strings.HasPrefix(k, ":") || strings.EqualFold(k, "Accept-Encoding") {
+			continue
+		}
+		req.Header.Set(k, v)
+	}
+	for _, c := range captured.Cookies {
+		req.AddCookie(&http.Cookie{Name: c.Name, Value: c.Value})
+	}
+
+	resp, err := ka.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read body: %w", err)
+	}
+
+	if isChallenge(resp, body) {
+		return nil, fmt.Errorf("status %d: %w", resp.StatusCode, domain.ErrAPIChallenge)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	data := &chromium.ProductsResponse{}
+	if err := json.Unmarshal(body, data); err != nil {
+		return nil, fmt.Errorf("unmarshal products response: %w", err)
+	}
+
+	res := make([]domain.Products, 0, len(data.Prods))
+	for _, p := range data.Prods {
+		res = append(res, p.ToDomain())
+	}
+
+	return res, nil
+}
+
+// isChallenge определяет, что вместо json с товарами api вернул страницу проверки на робота.
+func isChallenge(resp *http.Response, body []byte) bool {
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusTooManyRequests:
+		return true
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType == "text/html" {
+		return true
+	}
+
+	return !json.Valid(body) && strings.Contains(strings.ToLower(string(body)), "captcha")
+}
@@ -0,0 +1,135 @@
+package parsers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/vo1dFl0w/market-parser/internal/adapters/browser/chromium"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+)
+
+// GetCategories устанавливает адрес доставки и собирает дерево категорий из ответа api,
+// по которому kuper строит боковое меню категорий магазина.
+func (kp *kuper) GetCategories(ctx context.Context, address string, market string) ([]domain.Category, error) {
+	page, err := kp.openSession(ctx, address, "")
+	if err != nil {
+		return nil, err
+	}
+	defer page.CloseBrowser()
+	defer page.ClosePage()
+
+	marketPageURL := fmt.Sprintf("%s/%s", kp.cfg.BaseURL, market)
+	body, err := page.CaptureResponse(ctx, kp.cfg.ApiCategoriesPath, marketPageURL)
+	if err != nil {
+		return nil, fmt.Errorf("capture categories response: %w", err)
+	}
+
+	data := &chromium.CategoriesResponse{}
+	if err := json.Unmarshal([]byte(body), data); err != nil {
+		return nil, fmt.Errorf("unmarshal categories response: %w", err)
+	}
+
+	res := make([]domain.Category, 0, len(data.Categories))
+	for _, c := range data.Categories {
+		res = append(res, c.ToDomain())
+	}
+
+	return res, nil
+}
+
+// GetMarkets устанавливает адрес доставки на главной странице kuper и собирает карточки доступных магазинов.
+func (kp *kuper) GetMarkets(ctx context.Context, address string) ([]domain.Market, error) {
+	selector := kp.cfg.Selectors
+
+	page, err := kp.openSession(ctx, address, "")
+	if err != nil {
+		return nil, err
+	}
+	defer page.CloseBrowser()
+	defer page.ClosePage()
+
+	// после смены адреса список магазинов перерисовывается
+	if err := page.WaitDOMStable(ctx); err != nil {
+		return nil, fmt.Errorf("wait dom stable: %w", err)
+	}
+	if err := page.WaitVisible(ctx, selector.MarketCardSelector); err != nil {
+		return nil, fmt.Errorf("wait visible market card: %w", err)
+	}
+
+	cards, err := page.Elements(ctx, selector.MarketCardSelector)
+	if err != nil {
+		return nil, fmt.Errorf("elements market cards: %w", err)
+	}
+
+	res := make([]domain.Market, 0, len(cards))
+	seen := make(map[string]struct{}, len(cards))
+	for _, card := range cards {
+		market, err := kp.parseMarketCard(ctx, card)
+		if err != nil {
+			return nil, err
+		}
+		if market.Slug == "" {
+			continue
+		}
+		if _, ok := seen[market.Slug]; ok {
+			continue
+		}
+		seen[market.Slug] = struct{}{}
+		res = append(res, market)
+	}
+
+	return res, nil
+}
+
+func (kp *kuper) parseMarketCard(ctx context.Context, card repository.Element) (domain.Market, error) {
+	selector := kp.cfg.Selectors
+	market := domain.Market{}
+
+	// slug - первый сегмент пути ссылки карточки: /metro?sid=... -> metro
+	href, err := card.Attribute(ctx, "href")
+	if err != nil {
+		return market, fmt.Errorf("attribute market card href: %w", err)
+	}
+	u, err := url.Parse(href)
+	if err != nil {
+		return market, fmt.Errorf("parse market card href %s: %w", href, err)
+	}
+	market.Slug, _, _ = strings.Cut(strings.Trim(u.Path, "/"), "/")
+
+	b, logo, err := card.Has(ctx, selector.MarketLogoSelector)
+	if err != nil {
+		return market, fmt.Errorf("has market logo: %w", err)
+	}
+	if b {
+		if market.Name, err = logo.Attribute(ctx, "alt"); err != nil {
+			return market, fmt.Errorf("attribute market logo alt: %w", err)
+		}
+		if market.LogoURL, err = logo.Attribute(ctx, "src"); err != nil {
+			return market, fmt.Errorf("attribute market logo src: %w", err)
+		}
+	}
+	if market.Name == "" {
+		if market.Name, err = card.Text(ctx); err != nil {
+			return market, fmt.Errorf("text market card: %w", err)
+		}
+		market.Name = strings.TrimSpace(market.Name)
+	}
+
+	b, delivery, err := card.Has(ctx, selector.MarketDeliverySelector)
+	if err != nil {
+		return market, fmt.Errorf("has market delivery: %w", err)
+	}
+	if b {
+		text, err := delivery.Text(ctx)
+		if err != nil {
+			return market, fmt.Errorf("text market delivery: %w", err)
+		}
+		market.DeliveryInfo = strings.Join(strings.Fields(text), " ")
+	}
+
+	return market, nil
+}
@@ -0,0 +1,237 @@
+package parsers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+)
+
+// страницы сценария, на которых проверяются селекторы
+const (
+	checkPageHome     = "home"
+	checkPageAddress  = "address"
+	checkPageMarket   = "market"
+	checkPageCategory = "category"
+	checkPageProducts = "products"
+)
+
+type selectorSpec struct {
+	name     string
+	selector string
+	page     string
+	required bool
+}
+
+// CheckSelectors проходит сценарий парсинга kuper: главная страница, ввод адреса, карточки магазинов,
+// страница market и, если задана category, список товаров категории. Сессия с адресом не сохраняется.
+// Селекторы шагов, до которых сценарий не дошёл, возвращаются со статусом skipped.
+func (kp *kuper) CheckSelectors(ctx context.Context, address string, market string, category string) ([]domain.SelectorCheck, error) {
+	selector := kp.cfg.Selectors
+
+	specs := []selectorSpec{
+		{"smart_captcha_selector", selector.SmartCaptchaSelector, checkPageHome, false},
+		{"captcha_check_box", selector.CaptchaCheckBox, checkPageHome, false},
+		{"current_address_selector", selector.CurrentAddressSelector, checkPageHome, false},
+		{"address_button_selector", selector.AddressButtonSelector, checkPageHome, true},
+		{"address_input_selector", selector.AddressInputSelector, checkPageAddress, true},
+		{"address_input_drop_down_selector", selector.AddressInputDropDownSelector, checkPageAddress, true},
+		{"address_save_button_selector", selector.AddressSaveButtonSelector, checkPageAddress, true},
+		{"market_card_selector", selector.MarketCardSelector, checkPageHome, true},
+		{"market_logo_selector", selector.MarketCardSelector + " " + selector.MarketLogoSelector, checkPageHome, false},
+		{"market_delivery_selector", selector.MarketCardSelector + " " + selector.MarketDeliverySelector, checkPageHome, false},
+		{"market_selector", selector.MarketSelector, checkPageMarket, false},
+	}
+	if category != "" {
+		specs = append(specs,
+			selectorSpec{"category", fmt.Sprintf("span[title='%s']", category), checkPageMarket, true},
+			selectorSpec{"all_prods_selector", selector.AllProdsSelector, checkPageCategory, true},
+			// у категории из одной страницы пагинации нет
+			selectorSpec{"last_page_selector", selector.LastPageSelector, checkPageProducts, false},
+			selectorSpec{"last_page_text", selector.LastPageSelector + " " + selector.LastPageText, checkPageProducts, false},
+			selectorSpec{"next_page_selector", selector.NextPageSelector, checkPageProducts, false},
+		)
+	}
+
+	page, err := kp.browser.NewPage(ctx, kp.cfg.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("new page: %w", err)
+	}
+	defer page.CloseBrowser()
+	defer page.ClosePage()
+
+	if err := page.WaitLoad(ctx); err != nil {
+		return nil, fmt.Errorf("wait load: %w", err)
+	}
+	if err := page.WaitStable(ctx); err != nil {
+		return nil, fmt.Errorf("wait stable: %w", err)
+	}
+
+	c := &selectorChecker{page: page, specs: specs, done: make(map[string]domain.SelectorCheck, len(specs))}
+
+	// капча появляется не на каждом заходе, поэтому проверяется только её наличие
+	c.present(ctx, "smart_captcha_selector")
+	c.present(ctx, "captcha_check_box")
+	if err := page.CheckCaptcha(ctx, selector.CaptchaCheckBox, selector.SmartCaptchaSelector); err != nil {
+		return nil, fmt.Errorf("check captcha: %w", err)
+	}
+
+	c.visible(ctx, "current_address_selector")
+	if kp.checkAddress(ctx, c, address) {
+		if err := page.WaitDOMStable(ctx); err != nil {
+			return nil, fmt.Errorf("wait dom stable: %w", err)
+		}
+		c.visible(ctx, "market_card_selector")
+		c.visible(ctx, "market_logo_selector")
+		c.visible(ctx, "market_delivery_selector")
+
+		if err := kp.openMarket(ctx, page, market); err != nil {
+			return nil, err
+		}
+		c.visible(ctx, "market_selector")
+
+		if category != "" && c.click(ctx, "category") && c.click(ctx, "all_prods_selector") {
+			if err := page.WaitStable(ctx); err != nil {
+				return nil, fmt.Errorf("wait stable: %w", err)
+			}
+			c.visible(ctx, "last_page_selector")
+			c.visible(ctx, "last_page_text")
+			c.visible(ctx, "next_page_selector")
+		}
+	}
+
+	return c.result(), nil
+}
+
+// checkAddress вводит адрес доставки так же, как setDeliveryAddress, проверяя селектор каждого шага.
+func (kp *kuper) checkAddress(ctx context.Context, c *selectorChecker, address string) bool {
+	if !c.click(ctx, "address_button_selector") {
+		return false
+	}
+
+	input := c.visible(ctx, "address_input_selector")
+	if input == nil {
+		return false
+	}
+	if err := input.Input(ctx, address); err != nil {
+		c.fail("address_input_selector", fmt.Errorf("input address: %w", err))
+		return false
+	}
+
+	return c.click(ctx, "address_input_drop_down_selector") && c.click(ctx, "address_save_button_selector")
+}
+
+// selectorChecker копит результаты проверок в порядке specs.
+type selectorChecker struct {
+	page  repository.Page
+	specs []selectorSpec
+	done  map[string]domain.SelectorCheck
+}
+
+// present проверяет наличие элемента без ожидания.
+func (c *selectorChecker) present(ctx context.Context, name string) {
+	spec := c.spec(name)
+	res := domain.SelectorCheck{Name: spec.name, Selector: spec.selector, Page: spec.page, Required: spec.required}
+
+	start := time.Now()
+	b, _, err := c.page.Has(ctx, spec.selector)
+	res.Duration = time.Since(start)
+	switch {
+	case err != nil:
+		res.Status = domain.SelectorMissing
+		res.Err = err.Error()
+	case b:
+		res.Status = domain.SelectorFound
+	default:
+		res.Status = domain.SelectorMissing
+	}
+
+	c.save(ctx, res)
+}
+
+// visible ждёт видимости элемента и возвращает его, если он найден и виден.
+func (c *selectorChecker) visible(ctx context.Context, name string) repository.Element {
+	spec := c.spec(name)
+	res := domain.SelectorCheck{Name: spec.name, Selector: spec.selector, Page: spec.page, Required: spec.required}
+
+	var elem repository.Element
+	start := time.Now()
+	err := c.page.WaitVisible(ctx, spec.selector)
+	if err == nil {
+		elem, err = c.page.Element(ctx, spec.selector)
+	}
+	res.Duration = time.Since(start)
+
+	if err == nil {
+		res.Status = domain.SelectorFound
+	} else {
+		res.Err = err.Error()
+		res.Status = domain.SelectorMissing
+		// элемент есть в DOM, но так и не стал видимым
+		if b, _, hasErr := c.page.Has(ctx, spec.selector); hasErr == nil && b {
+			res.Status = domain.SelectorNotVisible
+		}
+	}
+
+	c.save(ctx, res)
+
+	return elem
+}
+
+// click проверяет селектор и нажимает на найденный элемент.
+func (c *selectorChecker) click(ctx context.Context, name string) bool {
+	elem := c.visible(ctx, name)
+	if elem == nil {
+		return false
+	}
+
+	if err := elem.Click(ctx); err != nil {
+		c.fail(name, fmt.Errorf("click: %w", err))
+		return false
+	}
+
+	return true
+}
+
+// fail записывает ошибку действия над найденным элементом.
+func (c *selectorChecker) fail(name string, err error) {
+	res := c.done[name]
+	res.Err = err.Error()
+	c.done[name] = res
+}
+
+func (c *selectorChecker) save(ctx context.Context, res domain.SelectorCheck) {
+	if res.Failed() {
+		// снимок страницы помогает понять, что поменялось в вёрстке
+		if img, err := c.page.Screenshot(ctx); err == nil {
+			res.Screenshot = img
+		}
+	}
+
+	c.done[res.Name] = res
+}
+
+func (c *selectorChecker) spec(name string) selectorSpec {
+	for _, spec := range c.specs {
+		if spec.name == name {
+			return spec
+		}
+	}
+
+	return selectorSpec{name: name}
+}
+
+func (c *selectorChecker) result() []domain.SelectorCheck {
+	res := make([]domain.SelectorCheck, 0, len(c.specs))
+	for _, spec := range c.specs {
+		check, ok := c.done[spec.name]
+		if !ok {
+			check = domain.SelectorCheck{Name: spec.name, Selector: spec.selector, Page: spec.page, Required: spec.required, Status: domain.SelectorSkipped}
+		}
+		res = append(res, check)
+	}
+
+	return res
+}
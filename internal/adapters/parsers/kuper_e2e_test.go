@@ -0,0 +1,222 @@
+//go:build e2e
+
+package parsers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/adapters/browser/chromium"
+	"github.com/vo1dFl0w/market-parser/internal/adapters/parsers/kupermock"
+	"github.com/vo1dFl0w/market-parser/internal/config"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+// e2e-тесты запускают настоящий chromium-адаптер и kuper в локальном headless chromium против kupermock
+// с селекторами из configs/config.yaml:
+//
+//	go test -tags e2e ./internal/adapters/parsers/
+const e2eAddress = "Москва, Тверская улица, 1"
+
+func newE2EKuper(t *testing.T) (*kuper, *kupermock.Site) {
+	t.Helper()
+
+	site := kupermock.NewSite(kupermock.DefaultMarkets())
+	t.Cleanup(site.Close)
+
+	t.Setenv("CONFIG_PATH", "../../../configs/config.yaml")
+	t.Setenv("SERVER_HTTP_ADDR", "localhost:0")
+	t.Setenv("BROWSER_WS_URL", "ws://localhost:0")
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	cfg.Providers.Kuper.BaseURL = &site.URL
+	// браузер запускается локально без окна, а не подключается к сервису chromium
+	cfg.Browser.Headless = false
+	cfg.Browser.HeadlessMode = true
+	cfg.Browser.TraceMode = false
+	cfg.Browser.TestParserMode = false
+	cfg.Browser.Proxy = config.ProxyConfig{}
+	cfg.Browser.RecordDir = ""
+
+	log := logger.LoadLogger(logger.NewLoggerConfig("local", time.Kitchen).WithOutput(io.Discard))
+	ch := chromium.NewChromium(cfg, log)
+	if err := ch.Start(context.Background()); err != nil {
+		t.Fatalf("start chromium: %v", err)
+	}
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+		defer cancel()
+		ch.Close(ctx)
+	})
+
+	return NewKuperParser(cfg, log, ch, nil), site
+}
+
+func e2eContext(t *testing.T) context.Context {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	t.Cleanup(cancel)
+
+	return ctx
+}
+
+func TestE2EGetAllProductsByCategory(t *testing.T) {
+	kp, site := newE2EKuper(t)
+
+	total := 0
+	ctx := domain.WithParseHooks(e2eContext(t), &domain.ParseHooks{
+		OnPages: func(n int) { total = n },
+	})
+	products, err := kp.GetAllProductsByCategory(ctx, "Мясо", e2eAddress, "metro")
+	if err != nil {
+		t.Fatalf("get all products by category: %v", err)
+	}
+
+	if total != 3 {
+		t.Errorf("pages = %d, want 3", total)
+	}
+	if len(products) != 23 {
+		t.Fatalf("got %d products, want 23", len(products))
+	}
+	for i, p := range products {
+		if want := fmt.Sprintf("Говядина %d", i+1); p.Name != want {
+			t.Errorf("products[%d].Name = %q, want %q", i, p.Name, want)
+		}
+	}
+	// каждый третий товар со скидкой 20%, каждый пятый не в наличии
+	if d := products[2].DiscountPercent; d == nil || *d != 20 {
+		t.Errorf("products[2] discount = %v, want 20", d)
+	}
+	if s := products[4].InStock; s == nil || *s {
+		t.Errorf("products[4] in stock = %v, want false", s)
+	}
+	if b := products[0].Brand; b == nil || *b != "Мираторг" {
+		t.Errorf("products[0] brand = %v, want Мираторг", b)
+	}
+	for pageNum := 1; pageNum <= 3; pageNum++ {
+		if site.ProductRequests(pageNum) == 0 {
+			t.Errorf("products api page %d was not requested", pageNum)
+		}
+	}
+}
+
+func TestE2EGetAllProductsByCategoryForMarkets(t *testing.T) {
+	kp, _ := newE2EKuper(t)
+
+	res, err := kp.GetAllProductsByCategoryForMarkets(e2eContext(t), "Рыба", e2eAddress, []string{"metro", "auchan"})
+	if err != nil {
+		t.Fatalf("get all products by category for markets: %v", err)
+	}
+
+	want := map[string]int{"metro": 4, "auchan": 3}
+	for _, mp := range res {
+		if mp.Err != nil {
+			t.Errorf("%s: %v", mp.Market, mp.Err)
+			continue
+		}
+		if len(mp.Products) != want[mp.Market] {
+			t.Errorf("%s: got %d products, want %d", mp.Market, len(mp.Products), want[mp.Market])
+		}
+	}
+}
+
+func TestE2ESearchProducts(t *testing.T) {
+	kp, _ := newE2EKuper(t)
+
+	products, err := kp.SearchProducts(e2eContext(t), domain.SearchFilter{Query: "Лосось"}, e2eAddress, "metro")
+	if err != nil {
+		t.Fatalf("search products: %v", err)
+	}
+	if len(products) != 4 {
+		t.Errorf("got %d products, want 4", len(products))
+	}
+}
+
+func TestE2EGetMarkets(t *testing.T) {
+	kp, site := newE2EKuper(t)
+
+	markets, err := kp.GetMarkets(e2eContext(t), e2eAddress)
+	if err != nil {
+		t.Fatalf("get markets: %v", err)
+	}
+
+	want := []domain.Market{
+		{Slug: "metro", Name: "METRO", LogoURL: "/static/img/metro.svg", DeliveryInfo: "Сегодня 10:00–12:00"},
+		{Slug: "auchan", Name: "Ашан", LogoURL: "/static/img/auchan.svg", DeliveryInfo: "Завтра 09:00–11:00"},
+	}
+	if len(markets) != len(want) {
+		t.Fatalf("got markets %+v, want %+v (site %s)", markets, want, site.URL)
+	}
+	for i := range want {
+		if markets[i] != want[i] {
+			t.Errorf("markets[%d] = %+v, want %+v", i, markets[i], want[i])
+		}
+	}
+}
+
+func TestE2EGetCategories(t *testing.T) {
+	kp, _ := newE2EKuper(t)
+
+	categories, err := kp.GetCategories(e2eContext(t), e2eAddress, "metro")
+	if err != nil {
+		t.Fatalf("get categories: %v", err)
+	}
+	if len(categories) != 2 || categories[0].Title != "Мясо" || categories[1].Slug != "ryba" {
+		t.Errorf("categories = %+v", categories)
+	}
+}
+
+// TestE2ECheckSelectors проверяет, что витрина покрывает все селекторы kuper из config.yaml.
+// Капчи на витрине нет, поэтому её селекторы не найдены, но они и не обязательные.
+func TestE2ECheckSelectors(t *testing.T) {
+	kp, _ := newE2EKuper(t)
+
+	checks, err := kp.CheckSelectors(e2eContext(t), e2eAddress, "metro", "Мясо")
+	if err != nil {
+		t.Fatalf("check selectors: %v", err)
+	}
+
+	for _, c := range checks {
+		switch c.Name {
+		case "smart_captcha_selector", "captcha_check_box":
+			if c.Required {
+				t.Errorf("%s is required", c.Name)
+			}
+		default:
+			if c.Status != domain.SelectorFound {
+				t.Errorf("%s (%s) = %s: %s", c.Name, c.Selector, c.Status, c.Err)
+			}
+		}
+	}
+}
+
+func TestE2ECaptchaSolvable(t *testing.T) {
+	kp, site := newE2EKuper(t)
+	site.SetCaptcha(kupermock.CaptchaSolvable)
+
+	products, err := kp.GetAllProductsByCategory(e2eContext(t), "Рыба", e2eAddress, "metro")
+	if err != nil {
+		t.Fatalf("get all products by category: %v", err)
+	}
+	if len(products) != 4 {
+		t.Errorf("got %d products, want 4", len(products))
+	}
+}
+
+func TestE2ECaptchaBlocking(t *testing.T) {
+	kp, site := newE2EKuper(t)
+	site.SetCaptcha(kupermock.CaptchaBlocking)
+
+	_, err := kp.GetAllProductsByCategory(e2eContext(t), "Рыба", e2eAddress, "metro")
+	if !errors.Is(err, domain.ErrCaptchaBlocked) {
+		t.Fatalf("got error %v, want %v", err, domain.ErrCaptchaBlocked)
+	}
+}
@@ -0,0 +1,80 @@
+package parsers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+)
+
+func (kp *kuper) SearchProducts(ctx context.Context, filter domain.SearchFilter, address string, market string) ([]domain.Products, error) {
+	page, err := kp.openSession(ctx, address, market)
+	if err != nil {
+		return nil, err
+	}
+	defer page.CloseBrowser()
+	defer page.ClosePage()
+
+	lastPageNum, err := kp.openSearch(ctx, page, filter, market)
+	if err != nil {
+		return nil, err
+	}
+
+	// при ошибке части страниц возвращаются товары разобранных страниц
+	res, err := page.ParsePages(ctx, 1, lastPageNum)
+	if err != nil {
+		return res, fmt.Errorf("parse pages: %w", err)
+	}
+
+	return res, nil
+}
+
+// openSearch переходит на страницу поиска market и возвращает номер последней страницы выдачи.
+// Сортировка и диапазон цен передаются магазину только если для них настроены параметры url.
+func (kp *kuper) openSearch(ctx context.Context, page repository.Page, filter domain.SearchFilter, market string) (int, error) {
+	selector := kp.cfg.Selectors
+
+	searchURL := kp.searchURL(filter, market)
+	if err := page.Navigate(ctx, searchURL); err != nil {
+		return 0, fmt.Errorf("navigate %s: %w", searchURL, err)
+	}
+	if err := page.WaitLoad(ctx); err != nil {
+		return 0, fmt.Errorf("wait load: %w", err)
+	}
+	if err := page.CheckCaptcha(ctx, selector.CaptchaCheckBox, selector.SmartCaptchaSelector); err != nil {
+		return 0, fmt.Errorf("check captcha: %w", err)
+	}
+
+	lastPageNum, err := page.FindLastPageNum(ctx, selector.LastPageSelector, selector.LastPageText)
+	if err != nil {
+		return 0, fmt.Errorf("find last page num: %w", err)
+	}
+
+	if kp.cfg.TestParserMode {
+		lastPageNum = testDefaultLastPageNum
+	}
+	domain.ParseHooksFromContext(ctx).Pages(lastPageNum)
+
+	return lastPageNum, nil
+}
+
+func (kp *kuper) searchURL(filter domain.SearchFilter, market string) string {
+	search := kp.cfg.Search
+
+	q := url.Values{}
+	q.Set(search.QueryParam, filter.Query)
+	if v, ok := search.SortValues[string(filter.Sort)]; ok && search.SortParam != "" {
+		q.Set(search.SortParam, v)
+	}
+	if filter.PriceMin != nil && search.PriceMinParam != "" {
+		q.Set(search.PriceMinParam, strconv.FormatFloat(*filter.PriceMin, 'f', -1, 64))
+	}
+	if filter.PriceMax != nil && search.PriceMaxParam != "" {
+		q.Set(search.PriceMaxParam, strconv.FormatFloat(*filter.PriceMax, 'f', -1, 64))
+	}
+
+	return fmt.Sprintf("%s/%s/%s?%s", kp.cfg.BaseURL, market, search.Path, q.Encode())
+}
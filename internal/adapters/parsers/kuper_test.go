@@ -0,0 +1,172 @@
+package parsers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/adapters/browser/chromium"
+	"github.com/vo1dFl0w/market-parser/internal/adapters/browser/replay"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+// testdata/kuper-metro-meat - запись сессии: ввод адреса на странице metro, категория "Мясо"
+// из двух страниц, на первой странице кроме products записан посторонний ответ api.
+const kuperFixtureDir = "testdata/kuper-metro-meat"
+
+func newReplayKuper(t *testing.T) *kuper {
+	t.Helper()
+
+	browser, err := replay.NewBrowser(kuperFixtureDir)
+	if err != nil {
+		t.Fatalf("new replay browser: %v", err)
+	}
+
+	return &kuper{
+		cfg: &KuperConfig{
+			BaseURL: "https://kuper.ru",
+			Selectors: &KuperSelectors{
+				SmartCaptchaSelector:         "label[for*='is-robot']",
+				CurrentAddressSelector:       "span[data-qa*='current-ship-address']",
+				AddressButtonSelector:        "button[data-qa*='select-button']",
+				AddressInputSelector:         "input[placeholder*='Ваш адрес']",
+				AddressInputDropDownSelector: "div[class*='SearchSelectForMap_dropdown']",
+				AddressSaveButtonSelector:    "span[class*='DeliveryMap2GIS']",
+				MarketSelector:               "img[alt='METRO']",
+				AllProdsSelector:             "a[title='Все товары категории']",
+				LastPageSelector:             "div[class*='last']",
+				LastPageText:                 "a[class*='link']",
+				NextPageSelector:             "div[class*='Pagination_next']",
+			},
+		},
+		browser: browser,
+		logger:  logger.LoadLogger(logger.NewLoggerConfig("local", time.Kitchen).WithOutput(io.Discard)),
+	}
+}
+
+func TestKuperGetAllProductsByCategoryReplay(t *testing.T) {
+	kp := newReplayKuper(t)
+
+	var total int
+	pages := map[int]int{}
+	streamed := 0
+	ctx := domain.WithParseHooks(context.Background(), &domain.ParseHooks{
+		OnPages:   func(n int) { total = n },
+		OnPage:    func(pageNum int, products []domain.Products) { pages[pageNum] = len(products) },
+		OnProduct: func(domain.Products) { streamed++ },
+	})
+
+	products, err := kp.GetAllProductsByCategory(ctx, "Мясо", "Москва, Тверская улица, 1", "metro")
+	if err != nil {
+		t.Fatalf("get all products by category: %v", err)
+	}
+
+	wantNames := []string{"Говядина вырезка охлаждённая", "Свинина шейка", "Фарш куриный"}
+	if len(products) != len(wantNames) {
+		t.Fatalf("got %d products, want %d: %+v", len(products), len(wantNames), products)
+	}
+	for i, name := range wantNames {
+		if products[i].Name != name {
+			t.Errorf("products[%d].Name = %q, want %q", i, products[i].Name, name)
+		}
+	}
+
+	beef := products[0]
+	if beef.Price != 899.9 || beef.URL != "https://kuper.ru/metro/govyadina-vyrezka" {
+		t.Errorf("beef = %+v", beef)
+	}
+	if beef.Brand == nil || *beef.Brand != "Мираторг" {
+		t.Errorf("beef brand = %v, want Мираторг", beef.Brand)
+	}
+	if beef.DiscountPercent == nil || *beef.DiscountPercent != 10 {
+		t.Errorf("beef discount = %v, want 10", beef.DiscountPercent)
+	}
+	// original_price без скидки совпадает с price
+	if products[1].DiscountPercent != nil {
+		t.Errorf("pork discount = %v, want nil", *products[1].DiscountPercent)
+	}
+	if products[2].InStock == nil || *products[2].InStock {
+		t.Errorf("mince in stock = %v, want false", products[2].InStock)
+	}
+
+	if total != 2 {
+		t.Errorf("hooks pages = %d, want 2", total)
+	}
+	if pages[1] != 2 || pages[2] != 1 {
+		t.Errorf("hooks page sizes = %v, want map[1:2 2:1]", pages)
+	}
+	if streamed != 3 {
+		t.Errorf("hooks products = %d, want 3", streamed)
+	}
+}
+
+func TestKuperGetAllProductsByCategoryReplayUnknownCategory(t *testing.T) {
+	kp := newReplayKuper(t)
+
+	_, err := kp.GetAllProductsByCategory(context.Background(), "Сыр", "Москва, Тверская улица, 1", "metro")
+	if err == nil {
+		t.Fatal("expected error for category missing in fixture")
+	}
+}
+
+func TestKuperParsePagesReplay(t *testing.T) {
+	kp := newReplayKuper(t)
+	ctx := context.Background()
+
+	page, err := kp.browser.NewPage(ctx, "https://kuper.ru/metro/c/myaso?sid=12&all=true")
+	if err != nil {
+		t.Fatalf("new page: %v", err)
+	}
+
+	// третьей страницы в записи нет: она не разобрана, но товары первых двух возвращаются вместе с ошибкой
+	products, err := page.ParsePages(ctx, 1, 3)
+	var pagesErr *domain.PagesError
+	if !errors.As(err, &pagesErr) || !slices.Equal(pagesErr.Pages, []int{3}) {
+		t.Fatalf("got error %v, want failed pages [3]", err)
+	}
+	if !errors.Is(err, chromium.ErrNoProductsResponse) {
+		t.Errorf("got error %v, want %v", err, chromium.ErrNoProductsResponse)
+	}
+	if len(products) != 3 {
+		t.Fatalf("got %d products, want 3", len(products))
+	}
+
+	// продолжение с середины списка, как после отката api-режима на браузер: первая страница не разбирается
+	page, err = kp.browser.NewPage(ctx, "https://kuper.ru/metro/c/myaso?sid=12&all=true")
+	if err != nil {
+		t.Fatalf("new page: %v", err)
+	}
+	pages := []int{}
+	resumeCtx := domain.WithParseHooks(ctx, &domain.ParseHooks{
+		OnPage: func(pageNum int, _ []domain.Products) { pages = append(pages, pageNum) },
+	})
+	products, err = page.ParsePages(resumeCtx, 2, 2)
+	if err != nil {
+		t.Fatalf("parse pages from 2: %v", err)
+	}
+	if len(products) != 1 || !slices.Equal(pages, []int{2}) {
+		t.Errorf("from page 2: got %d products and pages %v, want 1 and [2]", len(products), pages)
+	}
+
+	// при потоковом парсинге товары уходят только в хуки
+	page, err = kp.browser.NewPage(ctx, "https://kuper.ru/metro/c/myaso?sid=12&all=true")
+	if err != nil {
+		t.Fatalf("new page: %v", err)
+	}
+	streamed := 0
+	ctx = domain.WithParseHooks(ctx, &domain.ParseHooks{
+		Stream:    true,
+		OnProduct: func(domain.Products) { streamed++ },
+	})
+	products, err = page.ParsePages(ctx, 1, 2)
+	if err != nil {
+		t.Fatalf("parse pages streaming: %v", err)
+	}
+	if len(products) != 0 || streamed != 3 {
+		t.Errorf("streaming: got %d products and %d in hooks, want 0 and 3", len(products), streamed)
+	}
+}
@@ -0,0 +1,122 @@
+package kupermock
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PageSize - число товаров на странице выдачи.
+const PageSize = 10
+
+type Market struct {
+	Slug string
+	// Name - alt логотипа, по нему market_selector узнаёт страницу магазина
+	Name       string
+	Delivery   string
+	Categories []Category
+}
+
+type Category struct {
+	ID       int64
+	Title    string
+	Slug     string
+	Products []Product
+}
+
+type Product struct {
+	ID            string
+	Name          string
+	Price         float64
+	OriginalPrice float64
+	Brand         string
+	Volume        float64
+	VolumeType    string
+	Available     bool
+}
+
+// DefaultMarkets возвращает два магазина: в METRO категория "Мясо" занимает три страницы выдачи,
+// "Рыба" есть в обоих магазинах и помещается на одну страницу.
+func DefaultMarkets() []Market {
+	return []Market{
+		{
+			Slug:     "metro",
+			Name:     "METRO",
+			Delivery: "Сегодня 10:00–12:00",
+			Categories: []Category{
+				{ID: 1, Title: "Мясо", Slug: "myaso", Products: generate("metro-meat", "Говядина", "Мираторг", 23, 350)},
+				{ID: 2, Title: "Рыба", Slug: "ryba", Products: generate("metro-fish", "Лосось", "Русское море", 4, 1200)},
+			},
+		},
+		{
+			Slug:     "auchan",
+			Name:     "Ашан",
+			Delivery: "Завтра 09:00–11:00",
+			Categories: []Category{
+				{ID: 3, Title: "Рыба", Slug: "ryba", Products: generate("auchan-fish", "Треска", "Каждый день", 3, 600)},
+			},
+		},
+	}
+}
+
+// generate создаёт n товаров. Каждый третий со скидкой 20%, каждый пятый не в наличии.
+func generate(idPrefix string, name string, brand string, n int, basePrice float64) []Product {
+	res := make([]Product, 0, n)
+	for i := 1; i <= n; i++ {
+		p := Product{
+			ID:            fmt.Sprintf("%s-%d", idPrefix, i),
+			Name:          fmt.Sprintf("%s %d", name, i),
+			Price:         basePrice + float64(i),
+			OriginalPrice: basePrice + float64(i),
+			Brand:         brand,
+			Volume:        float64(100 * i),
+			VolumeType:    "g",
+			Available:     i%5 != 0,
+		}
+		if i%3 == 0 {
+			p.OriginalPrice = p.Price / 0.8
+		}
+		res = append(res, p)
+	}
+
+	return res
+}
+
+func (m *Market) category(slug string) (*Category, bool) {
+	for i := range m.Categories {
+		if m.Categories[i].Slug == slug {
+			return &m.Categories[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// search ищет товары всех категорий магазина по вхождению keywords в название без учёта регистра.
+func (m *Market) search(keywords string) []Product {
+	keywords = strings.ToLower(keywords)
+	res := []Product{}
+	for _, c := range m.Categories {
+		for _, p := range c.Products {
+			if strings.Contains(strings.ToLower(p.Name), keywords) {
+				res = append(res, p)
+			}
+		}
+	}
+
+	return res
+}
+
+// pages возвращает число страниц выдачи из n товаров, пустая выдача - одна страница.
+func pages(n int) int {
+	return max(1, (n+PageSize-1)/PageSize)
+}
+
+// page возвращает товары страницы pageNum (с 1), за последней страницей - пусто.
+func page(products []Product, pageNum int) []Product {
+	start := (pageNum - 1) * PageSize
+	if pageNum < 1 || start >= len(products) {
+		return []Product{}
+	}
+
+	return products[start:min(start+PageSize, len(products))]
+}
@@ -0,0 +1,57 @@
+package kupermock
+
+// формат ответов api kuper, которые перехватывает парсер
+
+type productsResponse struct {
+	Products []productJSON `json:"products"`
+}
+
+type productJSON struct {
+	ID                string    `json:"id"`
+	SKU               string    `json:"sku"`
+	Name              string    `json:"name"`
+	Price             float64   `json:"price"`
+	OriginalPrice     float64   `json:"original_price"`
+	CanonicalURL      string    `json:"canonical_url"`
+	Brand             brandJSON `json:"brand"`
+	Volume            float64   `json:"volume"`
+	VolumeType        string    `json:"volume_type"`
+	Available         bool      `json:"available"`
+	MaxSelectQuantity int       `json:"max_select_quantity"`
+	ImageURLs         []string  `json:"image_urls"`
+	Score             float64   `json:"score"`
+}
+
+type brandJSON struct {
+	Name string `json:"name"`
+}
+
+type categoriesResponse struct {
+	Categories []categoryJSON `json:"categories"`
+}
+
+type categoryJSON struct {
+	ID           int64          `json:"id"`
+	Name         string         `json:"name"`
+	Slug         string         `json:"slug"`
+	CanonicalURL string         `json:"canonical_url"`
+	Children     []categoryJSON `json:"children"`
+}
+
+func (s *Site) toJSON(m *Market, p Product) productJSON {
+	return productJSON{
+		ID:                p.ID,
+		SKU:               "sku-" + p.ID,
+		Name:              p.Name,
+		Price:             p.Price,
+		OriginalPrice:     p.OriginalPrice,
+		CanonicalURL:      s.URL + "/" + m.Slug + "/" + p.ID,
+		Brand:             brandJSON{Name: p.Brand},
+		Volume:            p.Volume,
+		VolumeType:        p.VolumeType,
+		Available:         p.Available,
+		MaxSelectQuantity: 10,
+		ImageURLs:         []string{s.URL + "/static/img/" + p.ID + ".svg"},
+		Score:             4.5,
+	}
+}
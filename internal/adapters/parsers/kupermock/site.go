@@ -0,0 +1,273 @@
+// Package kupermock - локальная витрина kuper на httptest для e2e-тестов парсера: главная страница с карточками
+// магазинов, окно ввода адреса, страница магазина с категориями, список товаров с пагинацией, api products и
+// categories и включаемая капча. Вёрстка повторяет селекторы providers.kuper из configs/config.yaml.
+package kupermock
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// CaptchaMode - поведение капчи на html-страницах.
+type CaptchaMode int
+
+const (
+	// CaptchaOff - капчи нет.
+	CaptchaOff CaptchaMode = iota
+	// CaptchaSolvable - капча показывается до первого клика по ней.
+	CaptchaSolvable
+	// CaptchaBlocking - клик по капче не помогает, она показывается снова.
+	CaptchaBlocking
+)
+
+const (
+	addressCookie = "address"
+	captchaCookie = "captcha_passed"
+)
+
+type Site struct {
+	*httptest.Server
+
+	markets []Market
+
+	mu      sync.Mutex
+	captcha CaptchaMode
+	// productRequests - число запросов к api products по номеру страницы
+	productRequests map[int]int
+}
+
+// NewSite запускает витрину с магазинами markets. Закрывается через Close.
+func NewSite(markets []Market) *Site {
+	s := &Site{markets: markets, productRequests: map[int]int{}}
+	s.Server = httptest.NewServer(s.routes())
+
+	return s
+}
+
+func (s *Site) SetCaptcha(mode CaptchaMode) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.captcha = mode
+}
+
+// ProductRequests возвращает, сколько раз запрашивалась страница pageNum api products.
+func (s *Site) ProductRequests(pageNum int) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.productRequests[pageNum]
+}
+
+func (s *Site) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /{$}", s.htmlPage(s.home))
+	mux.HandleFunc("GET /{market}", s.htmlPage(s.market))
+	mux.HandleFunc("GET /{market}/c/{category}", s.htmlPage(s.category))
+	mux.HandleFunc("GET /{market}/search", s.htmlPage(s.search))
+
+	mux.HandleFunc("POST /captcha/pass", s.passCaptcha)
+	mux.HandleFunc("GET /api/v3/stores/{market}/categories", s.apiCategories)
+	mux.HandleFunc("GET /api/v3/stores/{market}/products", s.apiProducts)
+	mux.HandleFunc("GET /static/img/{file}", s.static)
+	mux.HandleFunc("GET /favicon.ico", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	return mux
+}
+
+// pageData - общие данные шаблонов страниц.
+type pageData struct {
+	Address string
+	Market  *Market
+	Markets []Market
+
+	Category *Category
+	Keywords string
+	// PageNum и TotalPages - страница списка товаров, сами товары подгружаются скриптом из api products
+	PageNum    int
+	TotalPages int
+}
+
+// htmlPage показывает капчу вместо страницы, пока она не пройдена, и подставляет адрес доставки из cookie.
+func (s *Site) htmlPage(fn func(w http.ResponseWriter, r *http.Request, data *pageData)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		captcha := s.captcha
+		s.mu.Unlock()
+
+		if captcha != CaptchaOff {
+			if _, err := r.Cookie(captchaCookie); err != nil {
+				render(w, captchaTmpl, nil)
+				return
+			}
+		}
+
+		data := &pageData{}
+		if c, err := r.Cookie(addressCookie); err == nil {
+			data.Address, _ = url.QueryUnescape(c.Value)
+		}
+		fn(w, r, data)
+	}
+}
+
+func (s *Site) home(w http.ResponseWriter, r *http.Request, data *pageData) {
+	data.Markets = s.markets
+	render(w, homeTmpl, data)
+}
+
+func (s *Site) market(w http.ResponseWriter, r *http.Request, data *pageData) {
+	m, ok := s.find(r.PathValue("market"))
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	data.Market = m
+	render(w, marketTmpl, data)
+}
+
+// category показывает подкатегории со ссылкой "Все товары категории", а с all=true - список товаров.
+func (s *Site) category(w http.ResponseWriter, r *http.Request, data *pageData) {
+	m, ok := s.find(r.PathValue("market"))
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	c, ok := m.category(r.PathValue("category"))
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	data.Market = m
+	data.Category = c
+	if r.URL.Query().Get("all") != "true" {
+		render(w, categoryTmpl, data)
+		return
+	}
+
+	data.PageNum = pageNum(r)
+	data.TotalPages = pages(len(c.Products))
+	render(w, listingTmpl, data)
+}
+
+func (s *Site) search(w http.ResponseWriter, r *http.Request, data *pageData) {
+	m, ok := s.find(r.PathValue("market"))
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	data.Market = m
+	data.Keywords = r.URL.Query().Get("keywords")
+	data.PageNum = pageNum(r)
+	data.TotalPages = pages(len(m.search(data.Keywords)))
+	render(w, listingTmpl, data)
+}
+
+// passCaptcha засчитывает клик по капче. В режиме CaptchaBlocking капча не проходится.
+func (s *Site) passCaptcha(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	captcha := s.captcha
+	s.mu.Unlock()
+
+	if captcha == CaptchaBlocking {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{Name: captchaCookie, Value: "1", Path: "/"})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Site) apiCategories(w http.ResponseWriter, r *http.Request) {
+	m, ok := s.find(r.PathValue("market"))
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	res := categoriesResponse{Categories: make([]categoryJSON, 0, len(m.Categories))}
+	for _, c := range m.Categories {
+		res.Categories = append(res.Categories, categoryJSON{
+			ID:           c.ID,
+			Name:         c.Title,
+			Slug:         c.Slug,
+			CanonicalURL: s.URL + "/" + m.Slug + "/c/" + c.Slug,
+			Children:     []categoryJSON{},
+		})
+	}
+
+	writeJSON(w, res)
+}
+
+// apiProducts отдаёт страницу товаров категории (category) или поиска (keywords).
+func (s *Site) apiProducts(w http.ResponseWriter, r *http.Request) {
+	m, ok := s.find(r.PathValue("market"))
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	q := r.URL.Query()
+	var products []Product
+	if slug := q.Get("category"); slug != "" {
+		c, ok := m.category(slug)
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		products = c.Products
+	} else {
+		products = m.search(q.Get("keywords"))
+	}
+
+	num := pageNum(r)
+	s.mu.Lock()
+	s.productRequests[num]++
+	s.mu.Unlock()
+
+	res := productsResponse{Products: []productJSON{}}
+	for _, p := range page(products, num) {
+		res.Products = append(res.Products, s.toJSON(m, p))
+	}
+
+	writeJSON(w, res)
+}
+
+// static отдаёт логотипы магазинов.
+func (s *Site) static(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "image/svg+xml")
+	w.Write([]byte(`<svg xmlns="http://www.w3.org/2000/svg" width="64" height="64"><rect width="64" height="64" fill="#2a7"/></svg>`))
+}
+
+func (s *Site) find(slug string) (*Market, bool) {
+	for i := range s.markets {
+		if s.markets[i].Slug == slug {
+			return &s.markets[i], true
+		}
+	}
+
+	return nil, false
+}
+
+func pageNum(r *http.Request) int {
+	n, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || n < 1 {
+		return 1
+	}
+
+	return n
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
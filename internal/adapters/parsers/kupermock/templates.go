@@ -0,0 +1,171 @@
+package kupermock
+
+import (
+	"html/template"
+	"net/http"
+)
+
+// классы с суффиксами повторяют css-модули kuper, селекторы конфига ищут их по подстроке
+const layout = `
+{{define "head"}}<!DOCTYPE html>
+<html lang="ru"><head><meta charset="utf-8"><title>Купер</title>
+<style>
+body { font-family: sans-serif; margin: 0; padding: 16px; }
+a, span, button, div, input { display: inline-block; padding: 4px; margin: 2px; }
+.AddressModal_root__k2 { position: fixed; top: 80px; left: 80px; width: 480px; background: #fff; border: 1px solid #999; }
+.AddressModal_root__k2 > * { display: block; }
+</style>
+</head><body>{{end}}
+
+{{define "header"}}
+<header class="Header_root__a1">
+<span class="Header_address__b2" data-qa="header-current-ship-address">{{or .Address "Адрес доставки не указан"}}</span>
+<button class="Header_button__c3" data-qa="header-select-button" onclick="openAddress()">{{if .Address}}Изменить адрес{{else}}Укажите адрес доставки{{end}}</button>
+</header>
+<div id="address-modal"></div>
+<script>
+function openAddress() {
+	const modal = document.getElementById('address-modal');
+	modal.innerHTML = '<div class="AddressModal_root__k2"><input placeholder="Ваш адрес" autocomplete="off"></div>';
+	const root = modal.firstChild;
+	const input = root.querySelector('input');
+	input.addEventListener('input', () => {
+		let dropDown = root.querySelector('.SearchSelectForMap_dropdown__m4');
+		if (!input.value) {
+			if (dropDown) dropDown.remove();
+			return;
+		}
+		if (!dropDown) {
+			dropDown = document.createElement('div');
+			dropDown.className = 'SearchSelectForMap_dropdown__m4';
+			dropDown.addEventListener('click', () => selectAddress(root));
+			root.appendChild(dropDown);
+		}
+		dropDown.textContent = input.value;
+	});
+}
+function selectAddress(root) {
+	const dropDown = root.querySelector('.SearchSelectForMap_dropdown__m4');
+	root.querySelector('input').value = dropDown.textContent;
+	dropDown.remove();
+	if (!root.querySelector('.DeliveryMap2GIS_save__n5')) {
+		const save = document.createElement('span');
+		save.className = 'DeliveryMap2GIS_save__n5';
+		save.textContent = 'Сохранить';
+		save.addEventListener('click', () => {
+			document.cookie = 'address=' + encodeURIComponent(root.querySelector('input').value) + '; path=/';
+			location.reload();
+		});
+		root.appendChild(save);
+	}
+}
+</script>
+{{end}}
+
+{{define "categories"}}
+<aside class="CategoriesMenu_root__d4">
+{{range .Market.Categories}}<a class="CategoriesMenu_link__e5" href="/{{$.Market.Slug}}/c/{{.Slug}}?sid=1"><span title="{{.Title}}">{{.Title}}</span></a>
+{{end}}
+</aside>
+{{end}}
+
+{{define "market-logo"}}<img class="StoreLogo_root__p7" src="/static/img/{{.Slug}}.svg" alt="{{.Name}}" width="64" height="64">{{end}}
+`
+
+var (
+	homeTmpl = newTemplate(`
+{{template "head"}}
+{{template "header" .}}
+<main class="StoresList_root__f6">
+{{range .Markets}}<a class="StoreCard_root__g7" href="/{{.Slug}}?sid=1">{{template "market-logo" .}}<div class="StoreCard_delivery__h8">{{.Delivery}}</div></a>
+{{end}}
+</main>
+</body></html>`)
+
+	marketTmpl = newTemplate(`
+{{template "head"}}
+{{template "header" .}}
+{{template "market-logo" .Market}}
+{{template "categories" .}}
+<script>
+fetch('/api/v3/stores/' + {{.Market.Slug}} + '/categories');
+</script>
+</body></html>`)
+
+	categoryTmpl = newTemplate(`
+{{template "head"}}
+{{template "header" .}}
+{{template "categories" .}}
+<main>
+<h1>{{.Category.Title}}</h1>
+<a class="CategoryHeader_all__i9" title="Все товары категории" href="/{{.Market.Slug}}/c/{{.Category.Slug}}?sid=1&all=true">Все товары</a>
+</main>
+</body></html>`)
+
+	listingTmpl = newTemplate(`
+{{template "head"}}
+{{template "header" .}}
+{{if .Category}}{{template "categories" .}}{{end}}
+<main>
+<h1>{{if .Category}}{{.Category.Title}}{{else}}Поиск: {{.Keywords}}{{end}}</h1>
+<div class="ProductsGrid_root__j0" id="products"></div>
+{{if gt .TotalPages 1}}
+<div class="Pagination_root__q1">
+{{range seq 1 .TotalPages}}{{if lt . $.TotalPages}}<div class="Pagination_page__r2"><a class="Pagination_link__s3" href="#">{{.}}</a></div>{{end}}{{end}}
+<div class="Pagination_last__t4"><a class="Pagination_link__s3" href="#">{{.TotalPages}}</a></div>
+{{if lt .PageNum .TotalPages}}<div class="Pagination_next__u5">→</div>{{end}}
+</div>
+{{end}}
+</main>
+<script>
+const query = new URLSearchParams({page: {{.PageNum}}});
+{{if .Category}}query.set('category', {{.Category.Slug}});{{else}}query.set('keywords', {{.Keywords}});{{end}}
+fetch('/api/v3/stores/' + {{.Market.Slug}} + '/products?' + query)
+	.then(r => r.json())
+	.then(data => {
+		const grid = document.getElementById('products');
+		for (const p of data.products) {
+			const card = document.createElement('a');
+			card.className = 'ProductCard_root__v6';
+			card.href = p.canonical_url;
+			card.textContent = p.name + ' — ' + p.price + ' ₽';
+			grid.appendChild(card);
+		}
+	});
+</script>
+</body></html>`)
+
+	// капча повторяет SmartCaptcha: smart_captcha_selector ищет label[for*='is-robot']
+	captchaTmpl = newTemplate(`
+{{template "head"}}
+<div class="CheckboxCaptcha_root__w7">
+<label for="is-robot-checkbox"><input type="checkbox" id="is-robot-checkbox" class="captcha-checkbox" onchange="pass()">Я не робот</label>
+</div>
+<script>
+function pass() {
+	fetch('/captcha/pass', {method: 'POST'}).then(() => location.reload());
+}
+</script>
+</body></html>`)
+)
+
+var funcs = template.FuncMap{
+	"seq": func(from int, to int) []int {
+		res := make([]int, 0, to-from+1)
+		for i := from; i <= to; i++ {
+			res = append(res, i)
+		}
+		return res
+	},
+}
+
+func newTemplate(body string) *template.Template {
+	return template.Must(template.Must(template.New("layout").Funcs(funcs).Parse(layout)).New("page").Parse(body))
+}
+
+func render(w http.ResponseWriter, tmpl *template.Template, data any) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := tmpl.ExecuteTemplate(w, "page", data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
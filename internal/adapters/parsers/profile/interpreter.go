@@ -0,0 +1,233 @@
+package profile
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-rod/rod/lib/input"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+const defaultPageParam = "page"
+
+// interpreter выполняет шаги профиля на одной странице и копит разобранные товары.
+type interpreter struct {
+	profile  *Profile
+	page     repository.Page
+	logger   logger.Logger
+	vars     *strings.Replacer
+	urlVars  *strings.Replacer
+	products []domain.Products
+	// pageNum - сквозной номер перехваченной страницы для ParseHooks
+	pageNum int
+}
+
+func newInterpreter(profile *Profile, page repository.Page, logger logger.Logger, vars map[string]string) *interpreter {
+	pairs := make([]string, 0, len(vars)*2+2)
+	urlPairs := make([]string, 0, len(vars)*2+2)
+	for k, v := range vars {
+		pairs = append(pairs, "{"+k+"}", v)
+		urlPairs = append(urlPairs, "{"+k+"}", url.QueryEscape(v))
+	}
+	// base_url не экранируется, в url подставляется без завершающего /
+	pairs = append(pairs, "{base_url}", profile.BaseURL)
+	urlPairs = append(urlPairs, "{base_url}", strings.TrimSuffix(profile.BaseURL, "/"))
+
+	return &interpreter{
+		profile: profile,
+		page:    page,
+		logger:  logger,
+		vars:    strings.NewReplacer(pairs...),
+		urlVars: strings.NewReplacer(urlPairs...),
+	}
+}
+
+func (in *interpreter) exec(ctx context.Context, steps []Step) error {
+	for i, step := range steps {
+		if err := in.step(ctx, step); err != nil {
+			if ctx.Err() == nil && step.Optional {
+				in.logger.Warn("optional profile step failed", "provider", in.profile.Name, "step", i+1, "action", step.Action, "error", err)
+				continue
+			}
+			return fmt.Errorf("step %d %s: %w", i+1, step.Action, err)
+		}
+	}
+
+	return nil
+}
+
+func (in *interpreter) step(ctx context.Context, s Step) error {
+	selector := in.vars.Replace(s.Selector)
+
+	switch s.Action {
+	case ActionNavigate:
+		targetURL := in.urlVars.Replace(s.URL)
+		if err := in.page.Navigate(ctx, targetURL); err != nil {
+			return fmt.Errorf("navigate %s: %w", targetURL, err)
+		}
+		if err := in.page.WaitLoad(ctx); err != nil {
+			return fmt.Errorf("wait load: %w", err)
+		}
+	case ActionCheckCaptcha:
+		if err := in.page.CheckCaptcha(ctx, in.profile.Captcha.CheckBox, in.profile.Captcha.Selector); err != nil {
+			return fmt.Errorf("check captcha: %w", err)
+		}
+	case ActionClick:
+		if err := in.page.WaitVisible(ctx, selector); err != nil {
+			return fmt.Errorf("wait visible %s: %w", selector, err)
+		}
+		elem, err := in.page.Element(ctx, selector)
+		if err != nil {
+			return fmt.Errorf("element %s: %w", selector, err)
+		}
+		if err := elem.ScrollIntoView(ctx); err != nil {
+			return fmt.Errorf("scroll into view %s: %w", selector, err)
+		}
+		if err := elem.Click(ctx); err != nil {
+			return fmt.Errorf("click %s: %w", selector, err)
+		}
+	case ActionType:
+		elem, err := in.page.Element(ctx, selector)
+		if err != nil {
+			return fmt.Errorf("element %s: %w", selector, err)
+		}
+		// поле может быть заполнено (например, прошлым адресом), поэтому текст вводится в очищенное поле
+		if err := elem.Click(ctx); err != nil {
+			return fmt.Errorf("click %s: %w", selector, err)
+		}
+		if err := in.page.KeyboardType(ctx, input.ControlLeft, input.KeyA, input.Delete); err != nil {
+			return fmt.Errorf("clear %s: %w", selector, err)
+		}
+		if err := elem.Input(ctx, in.vars.Replace(s.Text)); err != nil {
+			return fmt.Errorf("input %s: %w", selector, err)
+		}
+	case ActionWaitVisible:
+		if err := in.page.WaitVisible(ctx, selector); err != nil {
+			return fmt.Errorf("wait visible %s: %w", selector, err)
+		}
+	case ActionWaitDOMStable:
+		if err := in.page.WaitDOMStable(ctx); err != nil {
+			return fmt.Errorf("wait dom stable: %w", err)
+		}
+	case ActionIntercept:
+		targetURL, err := in.targetURL(ctx, s.URL)
+		if err != nil {
+			return err
+		}
+		domain.ParseHooksFromContext(ctx).Pages(1)
+		if err := in.capture(ctx, s.URLPattern, targetURL); err != nil {
+			return err
+		}
+	case ActionPaginate:
+		return in.paginate(ctx, s)
+	default:
+		return fmt.Errorf("unknown action %q", s.Action)
+	}
+
+	return nil
+}
+
+// paginate перехватывает ответы страниц 1..N. N берётся из last_page_selector и ограничивается max_pages;
+// без last_page_selector парсится max_pages страниц, а без обоих - одна.
+func (in *interpreter) paginate(ctx context.Context, s Step) error {
+	baseURL, err := in.targetURL(ctx, s.URL)
+	if err != nil {
+		return err
+	}
+
+	lastPageNum := max(s.MaxPages, 1)
+	if s.LastPageSelector != "" {
+		lastPageNum, err = in.page.FindLastPageNum(ctx, in.vars.Replace(s.LastPageSelector), in.vars.Replace(s.LastPageText))
+		if err != nil {
+			return fmt.Errorf("find last page num: %w", err)
+		}
+		if s.MaxPages > 0 {
+			lastPageNum = min(lastPageNum, s.MaxPages)
+		}
+	}
+	domain.ParseHooksFromContext(ctx).Pages(lastPageNum)
+
+	pageParam := s.PageParam
+	if pageParam == "" {
+		pageParam = defaultPageParam
+	}
+
+	for i := 1; i <= lastPageNum; i++ {
+		targetURL, err := withQueryParam(baseURL, pageParam, strconv.Itoa(i))
+		if err != nil {
+			return err
+		}
+		if err := in.capture(ctx, s.URLPattern, targetURL); err != nil {
+			return fmt.Errorf("page %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// capture открывает targetURL, разбирает товары из ответа, url которого содержит urlPattern,
+// и передаёт их в ParseHooks. При потоковом парсинге товары не копятся.
+func (in *interpreter) capture(ctx context.Context, urlPattern string, targetURL string) error {
+	body, err := in.page.CaptureResponse(ctx, in.vars.Replace(urlPattern), targetURL)
+	if err != nil {
+		return fmt.Errorf("capture response: %w", err)
+	}
+
+	products, err := in.profile.mapProducts(body)
+	if err != nil {
+		return err
+	}
+
+	hooks := domain.ParseHooksFromContext(ctx)
+	in.pageNum++
+	for _, p := range products {
+		hooks.Product(p)
+	}
+	hooks.Page(in.pageNum, products)
+	if !hooks.Streaming() {
+		in.products = append(in.products, products...)
+	}
+
+	return nil
+}
+
+// targetURL возвращает url шага или, если он не задан, url текущей страницы.
+func (in *interpreter) targetURL(ctx context.Context, raw string) (string, error) {
+	if raw != "" {
+		return in.urlVars.Replace(raw), nil
+	}
+
+	pageURL, err := in.page.GetPageURL(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get page url: %w", err)
+	}
+
+	return pageURL, nil
+}
+
+// withQueryParam задаёт параметр url. Новый параметр дописывается в конец, не меняя порядок остальных,
+// как это делает адаптер kuper при переходе по страницам.
+func withQueryParam(rawURL string, key string, value string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("parse url %s: %w", rawURL, err)
+	}
+
+	q := u.Query()
+	switch {
+	case q.Has(key):
+		q.Set(key, value)
+		u.RawQuery = q.Encode()
+	case u.RawQuery == "":
+		u.RawQuery = url.Values{key: {value}}.Encode()
+	default:
+		u.RawQuery += "&" + url.Values{key: {value}}.Encode()
+	}
+
+	return u.String(), nil
+}
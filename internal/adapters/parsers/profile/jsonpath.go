@@ -0,0 +1,116 @@
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// segment - шаг пути: ключ объекта, индекс массива или [*] - все элементы массива.
+type segment struct {
+	key   string
+	index int
+	all   bool
+}
+
+// parsePath разбирает путь вида $.data.products[0].images[*].url. Префикс $ необязателен,
+// пустой путь указывает на сам документ.
+func parsePath(path string) ([]segment, error) {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if path == "" {
+		return nil, nil
+	}
+
+	res := []segment{}
+	for _, part := range strings.Split(path, ".") {
+		key, rest, bracket := strings.Cut(part, "[")
+		if key == "" && !bracket {
+			return nil, errors.New("empty key")
+		}
+		if bracket && rest == "" {
+			return nil, errors.New("unclosed [")
+		}
+		if key != "" {
+			res = append(res, segment{key: key})
+		}
+		for rest != "" {
+			idx, tail, ok := strings.Cut(rest, "]")
+			if !ok {
+				return nil, errors.New("unclosed [")
+			}
+			if idx == "*" {
+				res = append(res, segment{all: true})
+			} else {
+				n, err := strconv.Atoi(idx)
+				if err != nil || n < 0 {
+					return nil, fmt.Errorf("bad index %q", idx)
+				}
+				res = append(res, segment{index: n})
+			}
+			if tail == "" {
+				break
+			}
+			if !strings.HasPrefix(tail, "[") {
+				return nil, fmt.Errorf("unexpected %q after ]", tail)
+			}
+			if rest = tail[1:]; rest == "" {
+				return nil, errors.New("unclosed [")
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// lookup возвращает все значения по пути. Отсутствующие ключи и индексы дают пустой результат.
+func lookup(doc any, path string) []any {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil
+	}
+
+	values := []any{doc}
+	for _, seg := range segments {
+		next := []any{}
+		for _, v := range values {
+			switch {
+			case seg.key != "":
+				if obj, ok := v.(map[string]any); ok {
+					if child, ok := obj[seg.key]; ok && child != nil {
+						next = append(next, child)
+					}
+				}
+			case seg.all:
+				if arr, ok := v.([]any); ok {
+					for _, child := range arr {
+						if child != nil {
+							next = append(next, child)
+						}
+					}
+				}
+			default:
+				if arr, ok := v.([]any); ok && seg.index < len(arr) && arr[seg.index] != nil {
+					next = append(next, arr[seg.index])
+				}
+			}
+		}
+		values = next
+	}
+
+	return values
+}
+
+// first возвращает первое значение по пути. Пустой путь означает, что поле не задано.
+func first(doc any, path string) (any, bool) {
+	if path == "" {
+		return nil, false
+	}
+
+	values := lookup(doc, path)
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	return values[0], true
+}
@@ -0,0 +1,147 @@
+package profile
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    []segment
+		wantErr bool
+	}{
+		{path: "", want: nil},
+		{path: "$", want: nil},
+		{path: "products", want: []segment{{key: "products"}}},
+		{path: "$.data.products", want: []segment{{key: "data"}, {key: "products"}}},
+		{path: "brand.name", want: []segment{{key: "brand"}, {key: "name"}}},
+		{path: "images[0]", want: []segment{{key: "images"}, {index: 0}}},
+		{path: "images[*].url", want: []segment{{key: "images"}, {all: true}, {key: "url"}}},
+		{path: "matrix[1][2]", want: []segment{{key: "matrix"}, {index: 1}, {index: 2}}},
+		{path: "[*]", want: []segment{{all: true}}},
+		{path: "a..b", wantErr: true},
+		{path: "images[0", wantErr: true},
+		{path: "images[", wantErr: true},
+		{path: "images[0][", wantErr: true},
+		{path: "images[x]", wantErr: true},
+		{path: "images[-1]", wantErr: true},
+		{path: "images[0]url", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := parsePath(tt.path)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parsePath(%q) = %+v, want error", tt.path, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parsePath(%q): %v", tt.path, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parsePath(%q) = %+v, want %+v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	var doc any
+	err := json.Unmarshal([]byte(`{
+		"data": {
+			"products": [
+				{"name": "milk", "images": [{"url": "a.png"}, {"url": "b.png"}], "brand": null},
+				{"name": "bread", "images": []},
+				null
+			]
+		}
+	}`), &doc)
+	if err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		path string
+		want []any
+	}{
+		{name: "key", path: "data.products[0].name", want: []any{"milk"}},
+		{name: "all", path: "data.products[*].name", want: []any{"milk", "bread"}},
+		{name: "nested all", path: "data.products[*].images[*].url", want: []any{"a.png", "b.png"}},
+		{name: "index", path: "data.products[1].name", want: []any{"bread"}},
+		{name: "index out of range", path: "data.products[5].name", want: []any{}},
+		{name: "missing key", path: "data.items", want: []any{}},
+		{name: "null value", path: "data.products[0].brand", want: []any{}},
+		{name: "key on array", path: "data.products.name", want: []any{}},
+		{name: "index on object", path: "data[0]", want: []any{}},
+		{name: "bad syntax", path: "data.products[", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lookup(doc, tt.path); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("lookup(%q) = %#v, want %#v", tt.path, got, tt.want)
+			}
+		})
+	}
+
+	if _, ok := first(doc, ""); ok {
+		t.Error("first with empty path found a value")
+	}
+}
+
+func TestMapProducts(t *testing.T) {
+	p := &Profile{
+		BaseURL: "https://kuper.ru",
+		Products: Products{
+			Items: "products",
+			Fields: Fields{
+				Name:          "name",
+				Price:         "price",
+				URL:           "url",
+				Brand:         "brand.name",
+				OriginalPrice: "original_price",
+				InStock:       "available",
+				ImageURLs:     "images[*].url",
+			},
+		},
+	}
+
+	products, err := p.mapProducts(`{"products": [
+		{"name": "milk", "price": "1 299,90", "url": "/metro/milk", "brand": {"name": "Простоквашино"},
+			"original_price": 1624.875, "available": "true", "images": [{"url": "/img/milk.png"}]},
+		{"name": "no price"},
+		{"price": 10}
+	]}`)
+	if err != nil {
+		t.Fatalf("map products: %v", err)
+	}
+	if len(products) != 1 {
+		t.Fatalf("got %d products, want 1", len(products))
+	}
+
+	got := products[0]
+	if got.Name != "milk" || got.Price != 1299.9 || got.URL != "https://kuper.ru/metro/milk" {
+		t.Errorf("product = %+v", got)
+	}
+	if got.Brand == nil || *got.Brand != "Простоквашино" {
+		t.Errorf("brand = %v, want Простоквашино", got.Brand)
+	}
+	if got.InStock == nil || !*got.InStock {
+		t.Errorf("in stock = %v, want true", got.InStock)
+	}
+	if got.DiscountPercent == nil || *got.DiscountPercent != 20 {
+		t.Errorf("discount = %v, want 20", got.DiscountPercent)
+	}
+	if want := []string{"https://kuper.ru/img/milk.png"}; !reflect.DeepEqual(got.ImageURLs, want) {
+		t.Errorf("image urls = %v, want %v", got.ImageURLs, want)
+	}
+
+	if _, err := p.mapProducts("not json"); err == nil {
+		t.Error("expected error for invalid json")
+	}
+}
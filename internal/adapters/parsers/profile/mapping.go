@@ -0,0 +1,176 @@
+package profile
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+// mapProducts разбирает тело перехваченного ответа в товары по секции products профиля.
+// Элементы без названия или цены пропускаются.
+func (p *Profile) mapProducts(body string) ([]domain.Products, error) {
+	var doc any
+	if err := json.Unmarshal([]byte(body), &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	items := lookup(doc, p.Products.Items)
+	// путь без [*] указывает на сам массив товаров
+	if len(items) == 1 {
+		if arr, ok := items[0].([]any); ok {
+			items = arr
+		}
+	}
+
+	res := make([]domain.Products, 0, len(items))
+	for _, item := range items {
+		product, ok := p.mapProduct(item)
+		if ok {
+			res = append(res, product)
+		}
+	}
+
+	return res, nil
+}
+
+func (p *Profile) mapProduct(item any) (domain.Products, bool) {
+	f := p.Products.Fields
+
+	name := toString(item, f.Name)
+	price := toFloat(item, f.Price)
+	if name == nil || price == nil {
+		return domain.Products{}, false
+	}
+
+	res := domain.Products{
+		Name:            *name,
+		Price:           *price,
+		ID:              toString(item, f.ID),
+		SKU:             toString(item, f.SKU),
+		Brand:           toString(item, f.Brand),
+		PackSize:        toFloat(item, f.PackSize),
+		PackUnit:        toString(item, f.PackUnit),
+		OriginalPrice:   toFloat(item, f.OriginalPrice),
+		DiscountPercent: toFloat(item, f.DiscountPercent),
+		InStock:         toBool(item, f.InStock),
+		MaxQuantity:     toInt(item, f.MaxQuantity),
+		Rating:          toFloat(item, f.Rating),
+	}
+	if u := toString(item, f.URL); u != nil {
+		res.URL = p.absoluteURL(*u)
+	}
+	if f.ImageURLs != "" {
+		for _, v := range lookup(item, f.ImageURLs) {
+			if s, ok := v.(string); ok && s != "" {
+				res.ImageURLs = append(res.ImageURLs, p.absoluteURL(s))
+			}
+		}
+	}
+	// как и у kuper, скидка считается по цене до скидки, если агрегатор не прислал её сам
+	if res.DiscountPercent == nil && res.OriginalPrice != nil && *res.OriginalPrice > res.Price {
+		discount := math.Round((1 - res.Price / *res.OriginalPrice) * 100)
+		res.DiscountPercent = &discount
+	}
+
+	return res, true
+}
+
+// absoluteURL дополняет относительную ссылку адресом агрегатора.
+func (p *Profile) absoluteURL(raw string) string {
+	ref, err := url.Parse(raw)
+	if err != nil || ref.IsAbs() {
+		return raw
+	}
+
+	base, err := url.Parse(p.BaseURL)
+	if err != nil {
+		return raw
+	}
+
+	return base.ResolveReference(ref).String()
+}
+
+func toString(doc any, path string) *string {
+	v, ok := first(doc, path)
+	if !ok {
+		return nil
+	}
+
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = v
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		s = strconv.FormatBool(v)
+	default:
+		return nil
+	}
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+// toFloat принимает число или строку с числом, в том числе с запятой и пробелами: "1 299,90".
+func toFloat(doc any, path string) *float64 {
+	v, ok := first(doc, path)
+	if !ok {
+		return nil
+	}
+
+	switch v := v.(type) {
+	case float64:
+		return &v
+	case string:
+		s := strings.NewReplacer(" ", "", "\u00a0", "", ",", ".").Replace(v)
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil
+		}
+		return &f
+	default:
+		return nil
+	}
+}
+
+func toInt(doc any, path string) *int {
+	f := toFloat(doc, path)
+	if f == nil {
+		return nil
+	}
+
+	n := int(*f)
+
+	return &n
+}
+
+func toBool(doc any, path string) *bool {
+	v, ok := first(doc, path)
+	if !ok {
+		return nil
+	}
+
+	switch v := v.(type) {
+	case bool:
+		return &v
+	case string:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil
+		}
+		return &b
+	case float64:
+		b := v != 0
+		return &b
+	default:
+		return nil
+	}
+}
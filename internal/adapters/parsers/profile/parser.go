@@ -0,0 +1,86 @@
+package profile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+type parser struct {
+	profile *Profile
+	browser repository.BrowserRepository
+	logger  logger.Logger
+}
+
+// NewParser создаёт парсер, который выполняет сценарии профиля вместо отдельного адаптера на Go.
+func NewParser(profile *Profile, logger logger.Logger, browser repository.BrowserRepository) *parser {
+	return &parser{
+		profile: profile,
+		browser: browser,
+		logger:  logger.With("provider", profile.Name),
+	}
+}
+
+func (p *parser) GetAllProductsByCategory(ctx context.Context, category string, address string, market string) ([]domain.Products, error) {
+	return p.run(ctx, p.profile.Category, map[string]string{
+		"category": category,
+		"address":  address,
+		"market":   market,
+	})
+}
+
+// GetAllProductsByCategoryForMarkets выполняет сценарий категории для каждого market по очереди
+// в отдельной сессии браузера. Ошибка одного market не прерывает остальные.
+func (p *parser) GetAllProductsByCategoryForMarkets(ctx context.Context, category string, address string, markets []string) ([]domain.MarketProducts, error) {
+	res := make([]domain.MarketProducts, 0, len(markets))
+	for _, market := range markets {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		products, err := p.GetAllProductsByCategory(ctx, category, address, market)
+		res = append(res, domain.MarketProducts{Market: market, Products: products, Err: err})
+	}
+
+	return res, nil
+}
+
+// SearchProducts выполняет сценарий search. Сортировка и диапазон цен применяются к выдаче после парсинга.
+func (p *parser) SearchProducts(ctx context.Context, filter domain.SearchFilter, address string, market string) ([]domain.Products, error) {
+	if len(p.profile.Search) == 0 {
+		return nil, fmt.Errorf("%w: %s search", domain.ErrNotSupported, p.profile.Name)
+	}
+
+	return p.run(ctx, p.profile.Search, map[string]string{
+		"query":   filter.Query,
+		"address": address,
+		"market":  market,
+	})
+}
+
+func (p *parser) run(ctx context.Context, steps []Step, vars map[string]string) ([]domain.Products, error) {
+	page, err := p.browser.NewPage(ctx, p.profile.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("new page: %w", err)
+	}
+	defer page.CloseBrowser()
+	defer page.ClosePage()
+
+	if err := page.WaitLoad(ctx); err != nil {
+		return nil, fmt.Errorf("wait load: %w", err)
+	}
+
+	in := newInterpreter(p.profile, page, p.logger, vars)
+	if err := in.exec(ctx, steps); err != nil {
+		return nil, err
+	}
+
+	if in.products == nil {
+		return []domain.Products{}, nil
+	}
+
+	return in.products, nil
+}
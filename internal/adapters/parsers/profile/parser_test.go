@@ -0,0 +1,86 @@
+package profile
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/adapters/browser/replay"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+// запись сессии адаптера kuper: сценарий configs/profiles/kuper.yaml проходит по тем же страницам
+const kuperFixtureDir = "../testdata/kuper-metro-meat"
+
+func newReplayParser(t *testing.T) *parser {
+	t.Helper()
+
+	p, err := Load(kuperProfilePath)
+	if err != nil {
+		t.Fatalf("load profile: %v", err)
+	}
+	browser, err := replay.NewBrowser(kuperFixtureDir)
+	if err != nil {
+		t.Fatalf("new replay browser: %v", err)
+	}
+	log := logger.LoadLogger(logger.NewLoggerConfig("local", time.Kitchen).WithOutput(io.Discard))
+
+	return NewParser(p, log, browser)
+}
+
+func TestParserGetAllProductsByCategoryReplay(t *testing.T) {
+	p := newReplayParser(t)
+
+	var pages []int
+	ctx := domain.WithParseHooks(context.Background(), &domain.ParseHooks{
+		OnPage: func(pageNum int, products []domain.Products) { pages = append(pages, pageNum) },
+	})
+	products, err := p.GetAllProductsByCategory(ctx, "Мясо", "Москва, Тверская улица, 1", "metro")
+	if err != nil {
+		t.Fatalf("get all products by category: %v", err)
+	}
+
+	if len(pages) != 2 {
+		t.Errorf("got pages %v, want 2 pages", pages)
+	}
+	if len(products) != 3 {
+		t.Fatalf("got %d products, want 3", len(products))
+	}
+	for _, product := range products {
+		if product.Name == "" || product.Price <= 0 || product.URL == "" {
+			t.Errorf("incomplete product %+v", product)
+		}
+	}
+}
+
+func TestParserGetAllProductsByCategoryReplayUnknownCategory(t *testing.T) {
+	p := newReplayParser(t)
+
+	_, err := p.GetAllProductsByCategory(context.Background(), "Сыр", "Москва, Тверская улица, 1", "metro")
+	if err == nil {
+		t.Fatal("expected error for category missing in fixture")
+	}
+}
+
+func TestWithQueryParam(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "https://kuper.ru/metro/c/myaso", want: "https://kuper.ru/metro/c/myaso?page=2"},
+		{url: "https://kuper.ru/metro/c/myaso?sid=12&all=true", want: "https://kuper.ru/metro/c/myaso?sid=12&all=true&page=2"},
+		{url: "https://kuper.ru/metro/search?page=1&keywords=milk", want: "https://kuper.ru/metro/search?keywords=milk&page=2"},
+	}
+
+	for _, tt := range tests {
+		got, err := withQueryParam(tt.url, "page", "2")
+		if err != nil {
+			t.Fatalf("with query param %s: %v", tt.url, err)
+		}
+		if got != tt.want {
+			t.Errorf("withQueryParam(%s) = %s, want %s", tt.url, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package profile
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Action - действие шага профиля.
+type Action string
+
+const (
+	// ActionNavigate переходит по url и ждёт загрузки страницы.
+	ActionNavigate Action = "navigate"
+	// ActionCheckCaptcha проходит капчу селекторами из секции captcha.
+	ActionCheckCaptcha Action = "check_captcha"
+	// ActionClick дожидается видимости элемента и нажимает на него.
+	ActionClick Action = "click"
+	// ActionType очищает поле и вводит в него text.
+	ActionType Action = "type"
+	// ActionWaitVisible ждёт появления элемента.
+	ActionWaitVisible Action = "wait_visible"
+	// ActionWaitDOMStable ждёт, пока DOM страницы перестанет меняться.
+	ActionWaitDOMStable Action = "wait_dom_stable"
+	// ActionIntercept открывает url (по умолчанию текущую страницу) и разбирает товары
+	// из первого ответа, url которого содержит url_pattern.
+	ActionIntercept Action = "intercept"
+	// ActionPaginate повторяет intercept для страниц 1..N, подставляя номер в page_param.
+	ActionPaginate Action = "paginate"
+)
+
+// Profile - декларативное описание парсинга агрегатора: шаги сценария поверх repository.Page
+// и соответствие полей перехваченного ответа полям товара.
+type Profile struct {
+	// Name - имя агрегатора, передаётся в параметр provider.
+	Name     string   `yaml:"name"`
+	BaseURL  string   `yaml:"base_url"`
+	Captcha  Captcha  `yaml:"captcha"`
+	Category []Step   `yaml:"category"`
+	Search   []Step   `yaml:"search"`
+	Products Products `yaml:"products"`
+}
+
+type Captcha struct {
+	CheckBox string `yaml:"check_box"`
+	Selector string `yaml:"selector"`
+}
+
+// Step - один шаг сценария. В url, selector и text подставляются переменные
+// {base_url}, {market}, {address}, {category} и {query}; в url значения экранируются.
+// Ошибка шага с Optional пропускается.
+type Step struct {
+	Action           Action `yaml:"action"`
+	URL              string `yaml:"url"`
+	Selector         string `yaml:"selector"`
+	Text             string `yaml:"text"`
+	URLPattern       string `yaml:"url_pattern"`
+	PageParam        string `yaml:"page_param"`
+	LastPageSelector string `yaml:"last_page_selector"`
+	LastPageText     string `yaml:"last_page_text"`
+	MaxPages         int    `yaml:"max_pages"`
+	Optional         bool   `yaml:"optional"`
+}
+
+// Products описывает, где в теле ответа лежат товары. Items - путь к массиву товаров,
+// пути полей считаются от элемента массива.
+type Products struct {
+	Items  string `yaml:"items"`
+	Fields Fields `yaml:"fields"`
+}
+
+// Fields - пути к полям товара вида brand.name, images[0] или images[*].url.
+// Пустой путь означает, что агрегатор поле не присылает.
+type Fields struct {
+	Name            string `yaml:"name"`
+	Price           string `yaml:"price"`
+	URL             string `yaml:"url"`
+	ID              string `yaml:"id"`
+	SKU             string `yaml:"sku"`
+	Brand           string `yaml:"brand"`
+	PackSize        string `yaml:"pack_size"`
+	PackUnit        string `yaml:"pack_unit"`
+	OriginalPrice   string `yaml:"original_price"`
+	DiscountPercent string `yaml:"discount_percent"`
+	InStock         string `yaml:"in_stock"`
+	MaxQuantity     string `yaml:"max_quantity"`
+	ImageURLs       string `yaml:"image_urls"`
+	Rating          string `yaml:"rating"`
+}
+
+// Load читает профиль из yaml-файла. Неизвестные ключи считаются ошибкой,
+// чтобы опечатка в профиле не превращалась в молча пропущенный шаг.
+func Load(path string) (*Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read profile: %w", err)
+	}
+
+	p := &Profile{}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(p); err != nil {
+		return nil, fmt.Errorf("decode profile %s: %w", path, err)
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, fmt.Errorf("profile %s: %w", path, err)
+	}
+
+	return p, nil
+}
+
+func (p *Profile) Validate() error {
+	if p.Name == "" {
+		return errors.New("empty name")
+	}
+	if p.BaseURL == "" {
+		return errors.New("empty base_url")
+	}
+	if len(p.Category) == 0 {
+		return errors.New("empty category steps")
+	}
+	if p.Products.Fields.Name == "" || p.Products.Fields.Price == "" || p.Products.Fields.URL == "" {
+		return errors.New("products.fields name, price and url are required")
+	}
+
+	for i, step := range p.Category {
+		if err := step.validate(p); err != nil {
+			return fmt.Errorf("category step %d: %w", i+1, err)
+		}
+	}
+	for i, step := range p.Search {
+		if err := step.validate(p); err != nil {
+			return fmt.Errorf("search step %d: %w", i+1, err)
+		}
+	}
+
+	for _, path := range []string{p.Products.Items, p.Products.Fields.Name, p.Products.Fields.Price, p.Products.Fields.URL,
+		p.Products.Fields.ID, p.Products.Fields.SKU, p.Products.Fields.Brand, p.Products.Fields.PackSize,
+		p.Products.Fields.PackUnit, p.Products.Fields.OriginalPrice, p.Products.Fields.DiscountPercent,
+		p.Products.Fields.InStock, p.Products.Fields.MaxQuantity, p.Products.Fields.ImageURLs, p.Products.Fields.Rating} {
+		if _, err := parsePath(path); err != nil {
+			return fmt.Errorf("products path %q: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+func (s Step) validate(p *Profile) error {
+	switch s.Action {
+	case ActionNavigate:
+		if s.URL == "" {
+			return fmt.Errorf("%s: empty url", s.Action)
+		}
+	case ActionCheckCaptcha:
+		if p.Captcha.Selector == "" {
+			return fmt.Errorf("%s: empty captcha.selector", s.Action)
+		}
+	case ActionClick, ActionWaitVisible:
+		if s.Selector == "" {
+			return fmt.Errorf("%s: empty selector", s.Action)
+		}
+	case ActionType:
+		if s.Selector == "" || s.Text == "" {
+			return fmt.Errorf("%s: selector and text are required", s.Action)
+		}
+	case ActionWaitDOMStable:
+	case ActionIntercept, ActionPaginate:
+		if s.URLPattern == "" {
+			return fmt.Errorf("%s: empty url_pattern", s.Action)
+		}
+		if s.MaxPages < 0 {
+			return fmt.Errorf("%s: negative max_pages", s.Action)
+		}
+	default:
+		return fmt.Errorf("unknown action %q", s.Action)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package profile
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const kuperProfilePath = "../../../../configs/profiles/kuper.yaml"
+
+func TestValidate(t *testing.T) {
+	valid := func() *Profile {
+		return &Profile{
+			Name:     "test",
+			BaseURL:  "https://example.com",
+			Captcha:  Captcha{Selector: "#captcha"},
+			Category: []Step{{Action: ActionNavigate, URL: "{base_url}/{market}"}, {Action: ActionPaginate, URLPattern: "products"}},
+			Products: Products{Items: "products", Fields: Fields{Name: "name", Price: "price", URL: "url"}},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(p *Profile)
+		wantErr string
+	}{
+		{name: "valid", modify: func(p *Profile) {}},
+		{name: "empty name", modify: func(p *Profile) { p.Name = "" }, wantErr: "empty name"},
+		{name: "empty base url", modify: func(p *Profile) { p.BaseURL = "" }, wantErr: "empty base_url"},
+		{name: "no category steps", modify: func(p *Profile) { p.Category = nil }, wantErr: "empty category steps"},
+		{name: "no price field", modify: func(p *Profile) { p.Products.Fields.Price = "" }, wantErr: "name, price and url are required"},
+		{name: "unknown action", modify: func(p *Profile) { p.Category[0].Action = "hover" }, wantErr: `category step 1: unknown action "hover"`},
+		{name: "navigate without url", modify: func(p *Profile) { p.Category[0].URL = "" }, wantErr: "navigate: empty url"},
+		{
+			name:    "captcha without selector",
+			modify:  func(p *Profile) { p.Captcha.Selector = ""; p.Category[0] = Step{Action: ActionCheckCaptcha} },
+			wantErr: "check_captcha: empty captcha.selector",
+		},
+		{name: "click without selector", modify: func(p *Profile) { p.Category[0] = Step{Action: ActionClick} }, wantErr: "click: empty selector"},
+		{
+			name:    "type without text",
+			modify:  func(p *Profile) { p.Category[0] = Step{Action: ActionType, Selector: "input"} },
+			wantErr: "type: selector and text are required",
+		},
+		{name: "paginate without url pattern", modify: func(p *Profile) { p.Category[1].URLPattern = "" }, wantErr: "paginate: empty url_pattern"},
+		{name: "negative max pages", modify: func(p *Profile) { p.Category[1].MaxPages = -1 }, wantErr: "paginate: negative max_pages"},
+		{
+			name:    "bad search step",
+			modify:  func(p *Profile) { p.Search = []Step{{Action: ActionIntercept}} },
+			wantErr: "search step 1: intercept: empty url_pattern",
+		},
+		{name: "bad products path", modify: func(p *Profile) { p.Products.Fields.Brand = "brand[" }, wantErr: `products path "brand["`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := valid()
+			tt.modify(p)
+
+			err := p.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validate: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	if _, err := Load(kuperProfilePath); err != nil {
+		t.Fatalf("load kuper profile: %v", err)
+	}
+
+	// опечатка в ключе не должна превращаться в молча пропущенный шаг
+	path := filepath.Join(t.TempDir(), "typo.yaml")
+	data := "name: typo\nbase_url: https://example.com\ncategory:\n  - action: navigate\n    ulr: \"{base_url}\"\n"
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatalf("write profile: %v", err)
+	}
+	if _, err := Load(path); err == nil || !strings.Contains(err.Error(), "ulr") {
+		t.Fatalf("got error %v, want unknown key ulr", err)
+	}
+}
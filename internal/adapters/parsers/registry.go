@@ -0,0 +1,150 @@
+package parsers
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/vo1dFl0w/market-parser/internal/adapters/parsers/profile"
+	"github.com/vo1dFl0w/market-parser/internal/config"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+const ProviderKuper = "kuper"
+
+// Provider - реализации парсера одного агрегатора. Catalog может быть nil,
+// если агрегатор не отдаёт дерево категорий и список магазинов, Checker - если селекторы не проверяются.
+type Provider struct {
+	Parsers     map[domain.ParseMode]repository.ParserRepository
+	DefaultMode domain.ParseMode
+	Catalog     repository.CatalogRepository
+	Checker     repository.SelectorChecker
+}
+
+type registry struct {
+	providers       map[string]Provider
+	defaultProvider string
+}
+
+func NewRegistry(defaultProvider string) *registry {
+	return &registry{
+		providers:       make(map[string]Provider),
+		defaultProvider: defaultProvider,
+	}
+}
+
+// NewProviderRegistry регистрирует всех агрегаторов из конфигурации: адаптеры на Go и yaml-профили
+// из providers.profiles. Новый адаптер добавляется сюда вместе со своей секцией в config.ProvidersConfig.
+func NewProviderRegistry(cfg *config.Config, logger logger.Logger, browser repository.BrowserRepository, sessions repository.SessionRepository) (*registry, error) {
+	r := NewRegistry(cfg.Providers.Default)
+
+	kuperParser := NewKuperParser(cfg, logger, browser, sessions)
+	r.Register(ProviderKuper, Provider{
+		Parsers: map[domain.ParseMode]repository.ParserRepository{
+			domain.ParseModeBrowser: kuperParser,
+			domain.ParseModeAPI:     NewKuperAPIParser(logger, kuperParser),
+		},
+		DefaultMode: domain.ParseMode(cfg.Providers.Kuper.Mode),
+		Catalog:     kuperParser,
+		Checker:     kuperParser,
+	})
+
+	for _, path := range cfg.Providers.Profiles {
+		p, err := profile.Load(path)
+		if err != nil {
+			return nil, fmt.Errorf("load profile: %w", err)
+		}
+		if _, ok := r.providers[p.Name]; ok {
+			return nil, fmt.Errorf("profile %s: provider %s already registered", path, p.Name)
+		}
+		r.Register(p.Name, Provider{
+			Parsers: map[domain.ParseMode]repository.ParserRepository{
+				domain.ParseModeBrowser: profile.NewParser(p, logger, browser),
+			},
+			DefaultMode: domain.ParseModeBrowser,
+		})
+	}
+
+	if _, ok := r.providers[r.defaultProvider]; !ok {
+		return nil, fmt.Errorf("default provider: %w: %s", domain.ErrUnknownProvider, r.defaultProvider)
+	}
+
+	return r, nil
+}
+
+// Register добавляет агрегатора или заменяет ранее зарегистрированного с тем же именем.
+func (r *registry) Register(name string, provider Provider) {
+	r.providers[name] = provider
+}
+
+func (r *registry) Parser(provider string, mode domain.ParseMode) (repository.ParserRepository, error) {
+	_, p, err := r.provider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	if mode == "" {
+		mode = p.DefaultMode
+	}
+
+	parserRepo, ok := p.Parsers[mode]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", domain.ErrUnknownParseMode, mode)
+	}
+
+	return parserRepo, nil
+}
+
+func (r *registry) Catalog(provider string) (repository.CatalogRepository, error) {
+	name, p, err := r.provider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Catalog == nil {
+		return nil, fmt.Errorf("%w: %s catalog", domain.ErrNotSupported, name)
+	}
+
+	return p.Catalog, nil
+}
+
+func (r *registry) Checker(provider string) (repository.SelectorChecker, error) {
+	name, p, err := r.provider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Checker == nil {
+		return nil, fmt.Errorf("%w: %s selector check", domain.ErrNotSupported, name)
+	}
+
+	return p.Checker, nil
+}
+
+func (r *registry) DefaultProvider() string {
+	return r.defaultProvider
+}
+
+func (r *registry) Providers() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (r *registry) provider(name string) (string, Provider, error) {
+	if name == "" {
+		name = r.defaultProvider
+	}
+
+	p, ok := r.providers[name]
+	if !ok {
+		return "", Provider{}, fmt.Errorf("%w: %s", domain.ErrUnknownProvider, name)
+	}
+
+	return name, p, nil
+}
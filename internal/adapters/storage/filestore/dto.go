@@ -0,0 +1,58 @@
+package filestore
+
+import (
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+type sessionDTO struct {
+	Market       string            `json:"market"`
+	Address      string            `json:"address"`
+	Cookies      []cookieDTO       `json:"cookies"`
+	LocalStorage map[string]string `json:"local_storage"`
+	SavedAt      time.Time         `json:"saved_at"`
+	ExpiresAt    time.Time         `json:"expires_at"`
+}
+
+type cookieDTO struct {
+	Name     string    `json:"name"`
+	Value    string    `json:"value"`
+	Domain   string    `json:"domain"`
+	Path     string    `json:"path"`
+	Expires  time.Time `json:"expires,omitzero"`
+	HTTPOnly bool      `json:"http_only"`
+	Secure   bool      `json:"secure"`
+}
+
+func toSessionDTO(s *domain.AddressSession) *sessionDTO {
+	cookies := make([]cookieDTO, 0, len(s.Cookies))
+	for _, c := range s.Cookies {
+		cookies = append(cookies, cookieDTO(c))
+	}
+
+	return &sessionDTO{
+		Market:       s.Market,
+		Address:      s.Address,
+		Cookies:      cookies,
+		LocalStorage: s.LocalStorage,
+		SavedAt:      s.SavedAt,
+		ExpiresAt:    s.ExpiresAt,
+	}
+}
+
+func (s *sessionDTO) ToDomain() *domain.AddressSession {
+	cookies := make([]domain.Cookie, 0, len(s.Cookies))
+	for _, c := range s.Cookies {
+		cookies = append(cookies, domain.Cookie(c))
+	}
+
+	return &domain.AddressSession{
+		Market:       s.Market,
+		Address:      s.Address,
+		Cookies:      cookies,
+		LocalStorage: s.LocalStorage,
+		SavedAt:      s.SavedAt,
+		ExpiresAt:    s.ExpiresAt,
+	}
+}
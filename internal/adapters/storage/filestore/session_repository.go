@@ -0,0 +1,88 @@
+package filestore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+// sessionRepository хранит каждую сессию в отдельном json-файле каталога dir.
+type sessionRepository struct {
+	dir string
+	ttl time.Duration
+	mu  sync.Mutex
+}
+
+func NewSessionRepository(dir string, ttl time.Duration) (*sessionRepository, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("mkdir %s: %w", dir, err)
+	}
+
+	return &sessionRepository{dir: dir, ttl: ttl}, nil
+}
+
+func (r *sessionRepository) GetSession(ctx context.Context, market string, address string) (*domain.AddressSession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := os.ReadFile(r.path(market, address))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, domain.ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("read session: %w", err)
+	}
+
+	dto := &sessionDTO{}
+	if err := json.Unmarshal(data, dto); err != nil {
+		return nil, fmt.Errorf("unmarshal session: %w", err)
+	}
+
+	if time.Now().After(dto.ExpiresAt) {
+		return nil, domain.ErrSessionNotFound
+	}
+
+	return dto.ToDomain(), nil
+}
+
+func (r *sessionRepository) SaveSession(ctx context.Context, session *domain.AddressSession) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	dto := toSessionDTO(session)
+	dto.Address = domain.NormalizeAddress(session.Address)
+	dto.SavedAt = now
+	dto.ExpiresAt = now.Add(r.ttl)
+
+	data, err := json.Marshal(dto)
+	if err != nil {
+		return fmt.Errorf("marshal session: %w", err)
+	}
+
+	// пишем во временный файл и переименовываем, чтобы не оставить наполовину записанную сессию
+	path := r.path(session.Market, session.Address)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("write session: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("rename session: %w", err)
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) path(market string, address string) string {
+	sum := sha256.Sum256([]byte(market + "|" + domain.NormalizeAddress(address)))
+	return filepath.Join(r.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,107 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+// alertRepository хранит правила и отправленные оповещения в памяти процесса до перезапуска.
+type alertRepository struct {
+	mu    sync.Mutex
+	order []string
+	rules map[string]domain.AlertRule
+	fired map[string]map[string]string
+}
+
+func NewAlertRepository() *alertRepository {
+	return &alertRepository{
+		rules: make(map[string]domain.AlertRule),
+		fired: make(map[string]map[string]string),
+	}
+}
+
+func (r *alertRepository) SaveRule(ctx context.Context, rule *domain.AlertRule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.rules[rule.ID]; !ok {
+		r.order = append(r.order, rule.ID)
+	}
+	r.rules[rule.ID] = *rule
+
+	return nil
+}
+
+func (r *alertRepository) GetRule(ctx context.Context, id string) (*domain.AlertRule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rule, ok := r.rules[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", domain.ErrAlertRuleNotFound, id)
+	}
+
+	return &rule, nil
+}
+
+func (r *alertRepository) ListRules(ctx context.Context) ([]domain.AlertRule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]domain.AlertRule, 0, len(r.order))
+	for _, id := range r.order {
+		res = append(res, r.rules[id])
+	}
+
+	return res, nil
+}
+
+func (r *alertRepository) DeleteRule(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.rules[id]; !ok {
+		return fmt.Errorf("%w: %s", domain.ErrAlertRuleNotFound, id)
+	}
+	delete(r.rules, id)
+	delete(r.fired, id)
+	for i, ruleID := range r.order {
+		if ruleID == id {
+			r.order = append(r.order[:i], r.order[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (r *alertRepository) FiredAlerts(ctx context.Context, ruleID string) (map[string]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make(map[string]string, len(r.fired[ruleID]))
+	for key, fingerprint := range r.fired[ruleID] {
+		res[key] = fingerprint
+	}
+
+	return res, nil
+}
+
+func (r *alertRepository) SaveFiredAlert(ctx context.Context, ruleID string, key string, fingerprint string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if fingerprint == "" {
+		delete(r.fired[ruleID], key)
+		return nil
+	}
+	if r.fired[ruleID] == nil {
+		r.fired[ruleID] = make(map[string]string)
+	}
+	r.fired[ruleID][key] = fingerprint
+
+	return nil
+}
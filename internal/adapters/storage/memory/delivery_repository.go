@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+// deliveryRepository хранит журнал доставок в памяти процесса, не больше limit последних записей.
+type deliveryRepository struct {
+	mu         sync.Mutex
+	limit      int
+	order      []string
+	deliveries map[string]domain.WebhookDelivery
+}
+
+func NewDeliveryRepository(limit int) *deliveryRepository {
+	return &deliveryRepository{limit: limit, deliveries: make(map[string]domain.WebhookDelivery)}
+}
+
+func (r *deliveryRepository) SaveDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.deliveries[delivery.ID]; !ok {
+		r.order = append(r.order, delivery.ID)
+	}
+	d := *delivery
+	d.Attempts = slices.Clone(delivery.Attempts)
+	r.deliveries[delivery.ID] = d
+
+	// вытесняем самые старые записи
+	for r.limit > 0 && len(r.order) > r.limit {
+		delete(r.deliveries, r.order[0])
+		r.order = r.order[1:]
+	}
+
+	return nil
+}
+
+func (r *deliveryRepository) GetDelivery(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	d, ok := r.deliveries[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", domain.ErrDeliveryNotFound, id)
+	}
+	d.Attempts = slices.Clone(d.Attempts)
+
+	return &d, nil
+}
+
+func (r *deliveryRepository) ListDeliveries(ctx context.Context, filter domain.DeliveryFilter) ([]domain.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := []domain.WebhookDelivery{}
+	// новые записи первыми, как в sqlite
+	for i := len(r.order) - 1; i >= 0; i-- {
+		d := r.deliveries[r.order[i]]
+		if filter.JobID != "" && d.JobID != filter.JobID {
+			continue
+		}
+		if filter.Status != "" && d.Status != filter.Status {
+			continue
+		}
+		d.Attempts = slices.Clone(d.Attempts)
+		res = append(res, d)
+		if filter.Limit > 0 && len(res) >= filter.Limit {
+			break
+		}
+	}
+
+	return res, nil
+}
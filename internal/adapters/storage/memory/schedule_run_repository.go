@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+// scheduleRunRepository хранит в памяти не больше limit последних запусков каждого расписания.
+type scheduleRunRepository struct {
+	mu    sync.Mutex
+	limit int
+	runs  map[string][]domain.ScheduleRun
+}
+
+func NewScheduleRunRepository(limit int) *scheduleRunRepository {
+	return &scheduleRunRepository{limit: limit, runs: make(map[string][]domain.ScheduleRun)}
+}
+
+func (r *scheduleRunRepository) SaveRun(ctx context.Context, run *domain.ScheduleRun) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	saved := *run
+	saved.Targets = slices.Clone(run.Targets)
+
+	runs := r.runs[run.Schedule]
+	// запуск сохраняется при старте и ещё раз по завершении
+	if i := slices.IndexFunc(runs, func(r domain.ScheduleRun) bool { return r.ID == run.ID }); i >= 0 {
+		runs[i] = saved
+		return nil
+	}
+
+	runs = append(runs, saved)
+	if r.limit > 0 && len(runs) > r.limit {
+		runs = runs[len(runs)-r.limit:]
+	}
+	r.runs[run.Schedule] = runs
+
+	return nil
+}
+
+func (r *scheduleRunRepository) ListRuns(ctx context.Context, schedule string, limit int) ([]domain.ScheduleRun, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	runs := r.runs[schedule]
+	res := make([]domain.ScheduleRun, 0, min(len(runs), max(limit, 0)))
+	for i := len(runs) - 1; i >= 0; i-- {
+		if limit > 0 && len(res) >= limit {
+			break
+		}
+		run := runs[i]
+		run.Targets = slices.Clone(run.Targets)
+		res = append(res, run)
+	}
+
+	return res, nil
+}
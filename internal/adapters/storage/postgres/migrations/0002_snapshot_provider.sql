@@ -0,0 +1,5 @@
+-- до появления нескольких агрегаторов все снимки относились к kuper
+ALTER TABLE snapshots ADD COLUMN provider TEXT NOT NULL DEFAULT 'kuper';
+
+DROP INDEX snapshots_target;
+CREATE INDEX snapshots_target ON snapshots (provider, market, address_key, category, created_at);
@@ -0,0 +1,164 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+type alertRepository struct {
+	db *sql.DB
+}
+
+func NewAlertRepository(db *sql.DB) *alertRepository {
+	return &alertRepository{db: db}
+}
+
+func (r *alertRepository) SaveRule(ctx context.Context, rule *domain.AlertRule) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO alert_rules (id, name, kind, threshold, market, address, category, product_id, notifier, target, enabled, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET
+			name = excluded.name,
+			kind = excluded.kind,
+			threshold = excluded.threshold,
+			market = excluded.market,
+			address = excluded.address,
+			category = excluded.category,
+			product_id = excluded.product_id,
+			notifier = excluded.notifier,
+			target = excluded.target,
+			enabled = excluded.enabled,
+			updated_at = excluded.updated_at`,
+		rule.ID, rule.Name, string(rule.Kind), rule.Threshold, rule.Market, rule.Address, rule.Category, rule.ProductID,
+		string(rule.Notifier), rule.Target, rule.Enabled, rule.CreatedAt.UnixMilli(), rule.UpdatedAt.UnixMilli(),
+	)
+	if err != nil {
+		return fmt.Errorf("upsert alert rule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *alertRepository) GetRule(ctx context.Context, id string) (*domain.AlertRule, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT id, name, kind, threshold, market, address, category, product_id, notifier, target, enabled, created_at, updated_at
+		FROM alert_rules
+		WHERE id = ?`, id)
+
+	rule, err := scanAlertRule(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrAlertRuleNotFound, id)
+		}
+		return nil, fmt.Errorf("select alert rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+func (r *alertRepository) ListRules(ctx context.Context) ([]domain.AlertRule, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, name, kind, threshold, market, address, category, product_id, notifier, target, enabled, created_at, updated_at
+		FROM alert_rules
+		ORDER BY created_at`)
+	if err != nil {
+		return nil, fmt.Errorf("select alert rules: %w", err)
+	}
+	defer rows.Close()
+
+	res := []domain.AlertRule{}
+	for rows.Next() {
+		rule, err := scanAlertRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan alert rule: %w", err)
+		}
+		res = append(res, *rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return res, nil
+}
+
+func (r *alertRepository) DeleteRule(ctx context.Context, id string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM alert_rules WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("delete alert rule: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrAlertRuleNotFound, id)
+	}
+
+	return nil
+}
+
+func (r *alertRepository) FiredAlerts(ctx context.Context, ruleID string) (map[string]string, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT key, fingerprint FROM fired_alerts WHERE rule_id = ?`, ruleID)
+	if err != nil {
+		return nil, fmt.Errorf("select fired alerts: %w", err)
+	}
+	defer rows.Close()
+
+	res := map[string]string{}
+	for rows.Next() {
+		var key, fingerprint string
+		if err := rows.Scan(&key, &fingerprint); err != nil {
+			return nil, fmt.Errorf("scan fired alert: %w", err)
+		}
+		res[key] = fingerprint
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return res, nil
+}
+
+func (r *alertRepository) SaveFiredAlert(ctx context.Context, ruleID string, key string, fingerprint string) error {
+	if fingerprint == "" {
+		if _, err := r.db.ExecContext(ctx, `DELETE FROM fired_alerts WHERE rule_id = ? AND key = ?`, ruleID, key); err != nil {
+			return fmt.Errorf("delete fired alert: %w", err)
+		}
+		return nil
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO fired_alerts (rule_id, key, fingerprint)
+		VALUES (?, ?, ?)
+		ON CONFLICT (rule_id, key) DO UPDATE SET fingerprint = excluded.fingerprint`,
+		ruleID, key, fingerprint,
+	)
+	if err != nil {
+		return fmt.Errorf("upsert fired alert: %w", err)
+	}
+
+	return nil
+}
+
+func scanAlertRule(row interface{ Scan(dest ...any) error }) (*domain.AlertRule, error) {
+	var kind, notifier string
+	var createdAt, updatedAt int64
+	rule := &domain.AlertRule{}
+
+	err := row.Scan(&rule.ID, &rule.Name, &kind, &rule.Threshold, &rule.Market, &rule.Address, &rule.Category, &rule.ProductID,
+		&notifier, &rule.Target, &rule.Enabled, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+	rule.Kind = domain.AlertKind(kind)
+	rule.Notifier = domain.AlertNotifier(notifier)
+	rule.CreatedAt = time.UnixMilli(createdAt)
+	rule.UpdatedAt = time.UnixMilli(updatedAt)
+
+	return rule, nil
+}
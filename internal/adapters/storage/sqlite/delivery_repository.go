@@ -0,0 +1,163 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+type attemptDTO struct {
+	Attempt    int       `json:"attempt"`
+	At         time.Time `json:"at"`
+	StatusCode int       `json:"status_code,omitempty"`
+	Err        string    `json:"error,omitempty"`
+	DurationMs int64     `json:"duration_ms"`
+}
+
+type deliveryRepository struct {
+	db *sql.DB
+}
+
+func NewDeliveryRepository(db *sql.DB) *deliveryRepository {
+	return &deliveryRepository{db: db}
+}
+
+func (r *deliveryRepository) SaveDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	attempts := make([]attemptDTO, 0, len(delivery.Attempts))
+	for _, a := range delivery.Attempts {
+		attempts = append(attempts, attemptDTO{
+			Attempt:    a.Attempt,
+			At:         a.At,
+			StatusCode: a.StatusCode,
+			Err:        a.Err,
+			DurationMs: a.Duration.Milliseconds(),
+		})
+	}
+	attemptsJSON, err := json.Marshal(attempts)
+	if err != nil {
+		return fmt.Errorf("marshal attempts: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO webhook_deliveries (id, job_id, url, status, attempts, created_at, delivered_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET
+			status = excluded.status,
+			attempts = excluded.attempts,
+			delivered_at = excluded.delivered_at`,
+		delivery.ID, delivery.JobID, delivery.URL, string(delivery.Status), string(attemptsJSON),
+		delivery.CreatedAt.UnixMilli(), unixMilli(delivery.DeliveredAt),
+	)
+	if err != nil {
+		return fmt.Errorf("upsert delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (r *deliveryRepository) GetDelivery(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT id, job_id, url, status, attempts, created_at, delivered_at
+		FROM webhook_deliveries
+		WHERE id = ?`, id)
+
+	delivery, err := scanDelivery(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrDeliveryNotFound, id)
+		}
+		return nil, fmt.Errorf("select delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+func (r *deliveryRepository) ListDeliveries(ctx context.Context, filter domain.DeliveryFilter) ([]domain.WebhookDelivery, error) {
+	where := []string{}
+	args := []any{}
+	if filter.JobID != "" {
+		where = append(where, "job_id = ?")
+		args = append(args, filter.JobID)
+	}
+	if filter.Status != "" {
+		where = append(where, "status = ?")
+		args = append(args, string(filter.Status))
+	}
+
+	query := `SELECT id, job_id, url, status, attempts, created_at, delivered_at FROM webhook_deliveries`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY created_at DESC"
+	if filter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	res := []domain.WebhookDelivery{}
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan delivery: %w", err)
+		}
+		res = append(res, *delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return res, nil
+}
+
+func scanDelivery(row interface{ Scan(dest ...any) error }) (*domain.WebhookDelivery, error) {
+	var status, attemptsJSON string
+	var createdAt, deliveredAt int64
+	delivery := &domain.WebhookDelivery{}
+
+	if err := row.Scan(&delivery.ID, &delivery.JobID, &delivery.URL, &status, &attemptsJSON, &createdAt, &deliveredAt); err != nil {
+		return nil, err
+	}
+
+	attempts := []attemptDTO{}
+	if err := json.Unmarshal([]byte(attemptsJSON), &attempts); err != nil {
+		return nil, fmt.Errorf("unmarshal attempts: %w", err)
+	}
+
+	delivery.Status = domain.DeliveryStatus(status)
+	delivery.CreatedAt = time.UnixMilli(createdAt)
+	if deliveredAt > 0 {
+		delivery.DeliveredAt = time.UnixMilli(deliveredAt)
+	}
+	delivery.Attempts = make([]domain.WebhookAttempt, 0, len(attempts))
+	for _, a := range attempts {
+		delivery.Attempts = append(delivery.Attempts, domain.WebhookAttempt{
+			Attempt:    a.Attempt,
+			At:         a.At,
+			StatusCode: a.StatusCode,
+			Err:        a.Err,
+			Duration:   time.Duration(a.DurationMs) * time.Millisecond,
+		})
+	}
+
+	return delivery, nil
+}
+
+// unixMilli хранит нулевое время как 0, а не как отрицательное число миллисекунд.
+func unixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
@@ -0,0 +1,9 @@
+CREATE TABLE address_sessions (
+    market        TEXT    NOT NULL,
+    address       TEXT    NOT NULL,
+    cookies       TEXT    NOT NULL,
+    local_storage TEXT    NOT NULL,
+    saved_at      INTEGER NOT NULL,
+    expires_at    INTEGER NOT NULL,
+    PRIMARY KEY (market, address)
+);
@@ -0,0 +1,12 @@
+CREATE TABLE webhook_deliveries (
+    id           TEXT    PRIMARY KEY,
+    job_id       TEXT    NOT NULL,
+    url          TEXT    NOT NULL,
+    status       TEXT    NOT NULL,
+    attempts     TEXT    NOT NULL,
+    created_at   INTEGER NOT NULL,
+    delivered_at INTEGER NOT NULL
+);
+
+CREATE INDEX webhook_deliveries_job_id ON webhook_deliveries (job_id);
+CREATE INDEX webhook_deliveries_created_at ON webhook_deliveries (created_at);
@@ -0,0 +1,10 @@
+CREATE TABLE schedule_runs (
+    id          TEXT    PRIMARY KEY,
+    schedule    TEXT    NOT NULL,
+    status      TEXT    NOT NULL,
+    targets     TEXT    NOT NULL,
+    started_at  INTEGER NOT NULL,
+    finished_at INTEGER NOT NULL
+);
+
+CREATE INDEX schedule_runs_schedule_started_at ON schedule_runs (schedule, started_at);
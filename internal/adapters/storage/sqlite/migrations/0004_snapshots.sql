@@ -0,0 +1,27 @@
+CREATE TABLE snapshots (
+    id             TEXT    PRIMARY KEY,
+    market         TEXT    NOT NULL,
+    address        TEXT    NOT NULL,
+    address_key    TEXT    NOT NULL,
+    category       TEXT    NOT NULL,
+    source         TEXT    NOT NULL,
+    created_at     INTEGER NOT NULL,
+    products_count INTEGER NOT NULL
+);
+
+CREATE INDEX snapshots_target ON snapshots (market, address_key, category, created_at);
+
+CREATE TABLE snapshot_products (
+    snapshot_id TEXT    NOT NULL REFERENCES snapshots (id) ON DELETE CASCADE,
+    position    INTEGER NOT NULL,
+    url         TEXT    NOT NULL,
+    sku         TEXT,
+    name        TEXT    NOT NULL,
+    price       REAL    NOT NULL,
+    in_stock    INTEGER,
+    data        TEXT    NOT NULL,
+    PRIMARY KEY (snapshot_id, position)
+);
+
+CREATE INDEX snapshot_products_url ON snapshot_products (url);
+CREATE INDEX snapshot_products_sku ON snapshot_products (sku);
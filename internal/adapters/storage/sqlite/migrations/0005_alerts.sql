@@ -0,0 +1,22 @@
+CREATE TABLE alert_rules (
+    id         TEXT    PRIMARY KEY,
+    name       TEXT    NOT NULL,
+    kind       TEXT    NOT NULL,
+    threshold  REAL    NOT NULL,
+    market     TEXT    NOT NULL,
+    address    TEXT    NOT NULL,
+    category   TEXT    NOT NULL,
+    product_id TEXT    NOT NULL,
+    notifier   TEXT    NOT NULL,
+    target     TEXT    NOT NULL,
+    enabled    INTEGER NOT NULL,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL
+);
+
+CREATE TABLE fired_alerts (
+    rule_id     TEXT NOT NULL REFERENCES alert_rules (id) ON DELETE CASCADE,
+    key         TEXT NOT NULL,
+    fingerprint TEXT NOT NULL,
+    PRIMARY KEY (rule_id, key)
+);
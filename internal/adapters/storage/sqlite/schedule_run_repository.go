@@ -0,0 +1,120 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+type targetResultDTO struct {
+	Provider   string `json:"provider,omitempty"`
+	Market     string `json:"market"`
+	Address    string `json:"address"`
+	Category   string `json:"category"`
+	Products   int    `json:"products"`
+	Err        string `json:"error,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+type scheduleRunRepository struct {
+	db *sql.DB
+}
+
+func NewScheduleRunRepository(db *sql.DB) *scheduleRunRepository {
+	return &scheduleRunRepository{db: db}
+}
+
+func (r *scheduleRunRepository) SaveRun(ctx context.Context, run *domain.ScheduleRun) error {
+	targets := make([]targetResultDTO, 0, len(run.Targets))
+	for _, t := range run.Targets {
+		targets = append(targets, targetResultDTO{
+			Provider:   t.Target.Provider,
+			Market:     t.Target.Market,
+			Address:    t.Target.Address,
+			Category:   t.Target.Category,
+			Products:   t.Products,
+			Err:        t.Err,
+			DurationMs: t.Duration.Milliseconds(),
+		})
+	}
+	targetsJSON, err := json.Marshal(targets)
+	if err != nil {
+		return fmt.Errorf("marshal targets: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO schedule_runs (id, schedule, status, targets, started_at, finished_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET
+			status = excluded.status,
+			targets = excluded.targets,
+			finished_at = excluded.finished_at`,
+		run.ID, run.Schedule, string(run.Status), string(targetsJSON),
+		run.StartedAt.UnixMilli(), unixMilli(run.FinishedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("upsert schedule run: %w", err)
+	}
+
+	return nil
+}
+
+func (r *scheduleRunRepository) ListRuns(ctx context.Context, schedule string, limit int) ([]domain.ScheduleRun, error) {
+	query := `
+		SELECT id, schedule, status, targets, started_at, finished_at
+		FROM schedule_runs
+		WHERE schedule = ?
+		ORDER BY started_at DESC`
+	args := []any{schedule}
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select schedule runs: %w", err)
+	}
+	defer rows.Close()
+
+	res := []domain.ScheduleRun{}
+	for rows.Next() {
+		var status, targetsJSON string
+		var startedAt, finishedAt int64
+		run := domain.ScheduleRun{}
+
+		if err := rows.Scan(&run.ID, &run.Schedule, &status, &targetsJSON, &startedAt, &finishedAt); err != nil {
+			return nil, fmt.Errorf("scan schedule run: %w", err)
+		}
+
+		targets := []targetResultDTO{}
+		if err := json.Unmarshal([]byte(targetsJSON), &targets); err != nil {
+			return nil, fmt.Errorf("unmarshal targets: %w", err)
+		}
+
+		run.Status = domain.ScheduleRunStatus(status)
+		run.StartedAt = time.UnixMilli(startedAt)
+		if finishedAt > 0 {
+			run.FinishedAt = time.UnixMilli(finishedAt)
+		}
+		run.Targets = make([]domain.ScheduleTargetResult, 0, len(targets))
+		for _, t := range targets {
+			run.Targets = append(run.Targets, domain.ScheduleTargetResult{
+				Target:   domain.ScheduleTarget{Provider: t.Provider, Market: t.Market, Address: t.Address, Category: t.Category},
+				Products: t.Products,
+				Err:      t.Err,
+				Duration: time.Duration(t.DurationMs) * time.Millisecond,
+			})
+		}
+		res = append(res, run)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return res, nil
+}
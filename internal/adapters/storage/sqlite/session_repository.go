@@ -0,0 +1,105 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+type cookieDTO struct {
+	Name     string    `json:"name"`
+	Value    string    `json:"value"`
+	Domain   string    `json:"domain"`
+	Path     string    `json:"path"`
+	Expires  time.Time `json:"expires,omitzero"`
+	HTTPOnly bool      `json:"http_only"`
+	Secure   bool      `json:"secure"`
+}
+
+type sessionRepository struct {
+	db  *sql.DB
+	ttl time.Duration
+}
+
+func NewSessionRepository(db *sql.DB, ttl time.Duration) *sessionRepository {
+	return &sessionRepository{db: db, ttl: ttl}
+}
+
+func (r *sessionRepository) GetSession(ctx context.Context, market string, address string) (*domain.AddressSession, error) {
+	var cookiesJSON, localStorageJSON string
+	var savedAt, expiresAt int64
+
+	err := r.db.QueryRowContext(ctx, `
+		SELECT cookies, local_storage, saved_at, expires_at
+		FROM address_sessions
+		WHERE market = ? AND address = ? AND expires_at > ?`,
+		market, domain.NormalizeAddress(address), time.Now().Unix(),
+	).Scan(&cookiesJSON, &localStorageJSON, &savedAt, &expiresAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("select session: %w", err)
+	}
+
+	cookies := []cookieDTO{}
+	if err := json.Unmarshal([]byte(cookiesJSON), &cookies); err != nil {
+		return nil, fmt.Errorf("unmarshal cookies: %w", err)
+	}
+	localStorage := map[string]string{}
+	if err := json.Unmarshal([]byte(localStorageJSON), &localStorage); err != nil {
+		return nil, fmt.Errorf("unmarshal local storage: %w", err)
+	}
+
+	session := &domain.AddressSession{
+		Market:       market,
+		Address:      domain.NormalizeAddress(address),
+		Cookies:      make([]domain.Cookie, 0, len(cookies)),
+		LocalStorage: localStorage,
+		SavedAt:      time.Unix(savedAt, 0),
+		ExpiresAt:    time.Unix(expiresAt, 0),
+	}
+	for _, c := range cookies {
+		session.Cookies = append(session.Cookies, domain.Cookie(c))
+	}
+
+	return session, nil
+}
+
+func (r *sessionRepository) SaveSession(ctx context.Context, session *domain.AddressSession) error {
+	cookies := make([]cookieDTO, 0, len(session.Cookies))
+	for _, c := range session.Cookies {
+		cookies = append(cookies, cookieDTO(c))
+	}
+	cookiesJSON, err := json.Marshal(cookies)
+	if err != nil {
+		return fmt.Errorf("marshal cookies: %w", err)
+	}
+	localStorageJSON, err := json.Marshal(session.LocalStorage)
+	if err != nil {
+		return fmt.Errorf("marshal local storage: %w", err)
+	}
+
+	now := time.Now()
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO address_sessions (market, address, cookies, local_storage, saved_at, expires_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (market, address) DO UPDATE SET
+			cookies = excluded.cookies,
+			local_storage = excluded.local_storage,
+			saved_at = excluded.saved_at,
+			expires_at = excluded.expires_at`,
+		session.Market, domain.NormalizeAddress(session.Address), string(cookiesJSON), string(localStorageJSON),
+		now.Unix(), now.Add(r.ttl).Unix(),
+	)
+	if err != nil {
+		return fmt.Errorf("upsert session: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,249 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+// productDTO - товар снимка целиком. Поля для поиска по истории дублируются отдельными колонками.
+type productDTO struct {
+	Name            string   `json:"name"`
+	Price           float64  `json:"price"`
+	URL             string   `json:"url"`
+	ID              *string  `json:"id,omitempty"`
+	SKU             *string  `json:"sku,omitempty"`
+	Brand           *string  `json:"brand,omitempty"`
+	PackSize        *float64 `json:"pack_size,omitempty"`
+	PackUnit        *string  `json:"pack_unit,omitempty"`
+	OriginalPrice   *float64 `json:"original_price,omitempty"`
+	DiscountPercent *float64 `json:"discount_percent,omitempty"`
+	InStock         *bool    `json:"in_stock,omitempty"`
+	MaxQuantity     *int     `json:"max_quantity,omitempty"`
+	ImageURLs       []string `json:"image_urls,omitempty"`
+	Rating          *float64 `json:"rating,omitempty"`
+}
+
+type snapshotRepository struct {
+	db *sql.DB
+}
+
+func NewSnapshotRepository(db *sql.DB) *snapshotRepository {
+	return &snapshotRepository{db: db}
+}
+
+func (r *snapshotRepository) SaveSnapshot(ctx context.Context, snapshot *domain.Snapshot) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO snapshots (id, provider, market, address, address_key, category, source, created_at, products_count)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		snapshot.ID, snapshot.Provider, snapshot.Market, snapshot.Address, domain.NormalizeAddress(snapshot.Address), snapshot.Category,
+		string(snapshot.Source), snapshot.CreatedAt.UnixMilli(), len(snapshot.Products),
+	)
+	if err != nil {
+		return fmt.Errorf("insert snapshot: %w", err)
+	}
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO snapshot_products (snapshot_id, position, url, sku, name, price, in_stock, data)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return fmt.Errorf("prepare insert product: %w", err)
+	}
+	defer stmt.Close()
+
+	for i, p := range snapshot.Products {
+		data, err := json.Marshal(productDTO(p))
+		if err != nil {
+			return fmt.Errorf("marshal product: %w", err)
+		}
+		var inStock *int
+		if p.InStock != nil {
+			v := 0
+			if *p.InStock {
+				v = 1
+			}
+			inStock = &v
+		}
+		if _, err := stmt.ExecContext(ctx, snapshot.ID, i, domain.CanonicalProductURL(p.URL), p.SKU, p.Name, p.Price, inStock, string(data)); err != nil {
+			return fmt.Errorf("insert product: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+
+	return nil
+}
+
+func (r *snapshotRepository) GetSnapshot(ctx context.Context, id string) (*domain.Snapshot, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT id, provider, market, address, category, source, created_at, products_count
+		FROM snapshots
+		WHERE id = ?`, id)
+
+	snapshot, err := scanSnapshot(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrSnapshotNotFound, id)
+		}
+		return nil, fmt.Errorf("select snapshot: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT data FROM snapshot_products
+		WHERE snapshot_id = ?
+		ORDER BY position`, id)
+	if err != nil {
+		return nil, fmt.Errorf("select products: %w", err)
+	}
+	defer rows.Close()
+
+	snapshot.Products = make([]domain.Products, 0, snapshot.ProductsCount)
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, fmt.Errorf("scan product: %w", err)
+		}
+		p := productDTO{}
+		if err := json.Unmarshal([]byte(data), &p); err != nil {
+			return nil, fmt.Errorf("unmarshal product: %w", err)
+		}
+		snapshot.Products = append(snapshot.Products, domain.Products(p))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+func (r *snapshotRepository) ListSnapshots(ctx context.Context, filter domain.SnapshotFilter) ([]domain.Snapshot, error) {
+	where := []string{}
+	args := []any{}
+	if filter.Provider != "" {
+		where = append(where, "provider = ?")
+		args = append(args, filter.Provider)
+	}
+	if filter.Market != "" {
+		where = append(where, "market = ?")
+		args = append(args, filter.Market)
+	}
+	if filter.Address != "" {
+		where = append(where, "address_key = ?")
+		args = append(args, domain.NormalizeAddress(filter.Address))
+	}
+	if filter.Category != "" {
+		where = append(where, "category = ?")
+		args = append(args, filter.Category)
+	}
+
+	query := `SELECT id, provider, market, address, category, source, created_at, products_count FROM snapshots`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY created_at DESC"
+	if filter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select snapshots: %w", err)
+	}
+	defer rows.Close()
+
+	res := []domain.Snapshot{}
+	for rows.Next() {
+		snapshot, err := scanSnapshot(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan snapshot: %w", err)
+		}
+		res = append(res, *snapshot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return res, nil
+}
+
+func (r *snapshotRepository) ListPricePoints(ctx context.Context, filter domain.PriceHistoryFilter) ([]domain.PricePoint, error) {
+	where := []string{"(p.url = ? OR p.sku = ?)", "s.provider = ?", "s.market = ?", "s.address_key = ?"}
+	args := []any{domain.CanonicalProductURL(filter.ProductID), filter.ProductID, filter.Provider, filter.Market, domain.NormalizeAddress(filter.Address)}
+	if !filter.From.IsZero() {
+		where = append(where, "s.created_at >= ?")
+		args = append(args, filter.From.UnixMilli())
+	}
+	if !filter.To.IsZero() {
+		where = append(where, "s.created_at <= ?")
+		args = append(args, filter.To.UnixMilli())
+	}
+
+	// товар может встретиться в снимке несколько раз (повтор страницы, совпадение и по url, и по sku),
+	// в историю попадает одна точка на снимок - первая по позиции
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, created_at, name, url, sku, price, in_stock
+		FROM (
+			SELECT s.id, s.created_at, p.name, p.url, p.sku, p.price, p.in_stock,
+				ROW_NUMBER() OVER (PARTITION BY s.id ORDER BY p.position) AS rn
+			FROM snapshot_products p
+			JOIN snapshots s ON s.id = p.snapshot_id
+			WHERE `+strings.Join(where, " AND ")+`
+		) points
+		WHERE rn = 1
+		ORDER BY created_at, id`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select price points: %w", err)
+	}
+	defer rows.Close()
+
+	res := []domain.PricePoint{}
+	for rows.Next() {
+		var createdAt int64
+		var inStock *int
+		point := domain.PricePoint{}
+		if err := rows.Scan(&point.SnapshotID, &createdAt, &point.Name, &point.URL, &point.SKU, &point.Price, &inStock); err != nil {
+			return nil, fmt.Errorf("scan price point: %w", err)
+		}
+		point.At = time.UnixMilli(createdAt)
+		if inStock != nil {
+			v := *inStock != 0
+			point.InStock = &v
+		}
+		res = append(res, point)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return res, nil
+}
+
+func scanSnapshot(row interface{ Scan(dest ...any) error }) (*domain.Snapshot, error) {
+	var source string
+	var createdAt int64
+	snapshot := &domain.Snapshot{}
+
+	err := row.Scan(&snapshot.ID, &snapshot.Provider, &snapshot.Market, &snapshot.Address, &snapshot.Category, &source, &createdAt, &snapshot.ProductsCount)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Source = domain.SnapshotSource(source)
+	snapshot.CreatedAt = time.UnixMilli(createdAt)
+
+	return snapshot, nil
+}
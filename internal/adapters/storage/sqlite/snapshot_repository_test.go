@@ -0,0 +1,111 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+func TestSnapshotRepositoryListPricePoints(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := Open(ctx, ":memory:")
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	repo := NewSnapshotRepository(db)
+
+	sku := "12345"
+	milk := func(price float64) domain.Products {
+		return domain.Products{Name: "milk", Price: price, URL: "https://kuper.ru/metro/milk?sid=1", SKU: &sku}
+	}
+	kefir := domain.Products{Name: "kefir", Price: 80, URL: "https://kuper.ru/metro/kefir"}
+	start := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	snapshots := []*domain.Snapshot{
+		// товар повторился на перезапрошенной странице
+		{ID: "s1", Address: "Москва, Тверская улица, 1", CreatedAt: start, Products: []domain.Products{milk(100), kefir, milk(100)}},
+		{ID: "s2", Address: "Москва, Тверская улица, 1", CreatedAt: start.Add(time.Hour), Products: []domain.Products{kefir, milk(90)}},
+		{ID: "s3", Address: "Москва, Тверская улица, 1", CreatedAt: start.Add(2 * time.Hour), Products: []domain.Products{kefir}},
+		{ID: "other-address", Address: "Москва, Арбат, 1", CreatedAt: start.Add(time.Hour), Products: []domain.Products{milk(50)}},
+		{ID: "other-provider", Provider: "kuper-yaml", Address: "Москва, Тверская улица, 1", CreatedAt: start.Add(time.Hour), Products: []domain.Products{milk(70)}},
+		{ID: "s4", Address: "москва, тверская улица, 1", CreatedAt: start.Add(3 * time.Hour), Products: []domain.Products{milk(95), milk(95)}},
+	}
+	for _, s := range snapshots {
+		if s.Provider == "" {
+			s.Provider = "kuper"
+		}
+		s.Market, s.Category, s.Source = "metro", "Молоко", domain.SnapshotSourceHTTP
+		if err := repo.SaveSnapshot(ctx, s); err != nil {
+			t.Fatalf("save snapshot %s: %v", s.ID, err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		filter domain.PriceHistoryFilter
+		want   []string
+		prices []float64
+	}{
+		{
+			name:   "by url",
+			filter: domain.PriceHistoryFilter{Provider: "kuper", ProductID: "https://kuper.ru/metro/milk/", Market: "metro", Address: "Москва, Тверская улица, 1"},
+			want:   []string{"s1", "s2", "s4"},
+			prices: []float64{100, 90, 95},
+		},
+		{
+			name:   "by sku",
+			filter: domain.PriceHistoryFilter{Provider: "kuper", ProductID: sku, Market: "metro", Address: "Москва, Тверская улица, 1"},
+			want:   []string{"s1", "s2", "s4"},
+			prices: []float64{100, 90, 95},
+		},
+		{
+			name:   "time window",
+			filter: domain.PriceHistoryFilter{Provider: "kuper", ProductID: sku, Market: "metro", Address: "Москва, Тверская улица, 1", From: start.Add(time.Minute), To: start.Add(2 * time.Hour)},
+			want:   []string{"s2"},
+			prices: []float64{90},
+		},
+		{
+			name:   "other provider",
+			filter: domain.PriceHistoryFilter{Provider: "kuper-yaml", ProductID: sku, Market: "metro", Address: "Москва, Тверская улица, 1"},
+			want:   []string{"other-provider"},
+			prices: []float64{70},
+		},
+		{
+			name:   "other market",
+			filter: domain.PriceHistoryFilter{Provider: "kuper", ProductID: sku, Market: "auchan", Address: "Москва, Тверская улица, 1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points, err := repo.ListPricePoints(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("list price points: %v", err)
+			}
+
+			if len(points) != len(tt.want) {
+				t.Fatalf("got %d points %+v, want snapshots %v", len(points), points, tt.want)
+			}
+			for i, p := range points {
+				if p.SnapshotID != tt.want[i] || p.Price != tt.prices[i] {
+					t.Errorf("point %d = %s %.2f, want %s %.2f", i, p.SnapshotID, p.Price, tt.want[i], tt.prices[i])
+				}
+				if p.Name != "milk" || p.URL != "https://kuper.ru/metro/milk" || p.SKU == nil || *p.SKU != sku {
+					t.Errorf("point %d = %+v", i, p)
+				}
+			}
+		})
+	}
+
+	list, err := repo.ListSnapshots(ctx, domain.SnapshotFilter{Provider: "kuper-yaml"})
+	if err != nil {
+		t.Fatalf("list snapshots: %v", err)
+	}
+	if len(list) != 1 || list[0].ID != "other-provider" || list[0].Provider != "kuper-yaml" {
+		t.Errorf("snapshots of kuper-yaml = %+v, want other-provider", list)
+	}
+}
@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+// payload - тело запроса на callback_url. Товары описаны той же схемой, что и в ответе /parse.
+type payload struct {
+	DeliveryID  string       `json:"delivery_id"`
+	JobID       string       `json:"job_id"`
+	Status      string       `json:"status"`
+	Category    string       `json:"category"`
+	Address     string       `json:"address"`
+	Market      string       `json:"market"`
+	Products    []productDTO `json:"products"`
+	FailedPages []int        `json:"failed_pages,omitempty"`
+	Error       string       `json:"error,omitempty"`
+	FinishedAt  time.Time    `json:"finished_at"`
+}
+
+type productDTO struct {
+	Name            string   `json:"name"`
+	Price           float64  `json:"price"`
+	Link            string   `json:"link"`
+	ID              *string  `json:"id,omitempty"`
+	SKU             *string  `json:"sku,omitempty"`
+	Brand           *string  `json:"brand,omitempty"`
+	PackSize        *float64 `json:"pack_size,omitempty"`
+	PackUnit        *string  `json:"pack_unit,omitempty"`
+	OriginalPrice   *float64 `json:"original_price,omitempty"`
+	DiscountPercent *float64 `json:"discount_percent,omitempty"`
+	InStock         *bool    `json:"in_stock,omitempty"`
+	MaxQuantity     *int     `json:"max_quantity,omitempty"`
+	ImageURLs       []string `json:"image_urls,omitempty"`
+	Rating          *float64 `json:"rating,omitempty"`
+}
+
+func toPayload(deliveryID string, job *domain.Job, errMessage func(error) string) *payload {
+	res := &payload{
+		DeliveryID:  deliveryID,
+		JobID:       job.ID,
+		Status:      string(job.Status),
+		Category:    job.Params.Category,
+		Address:     job.Params.Address,
+		Market:      job.Params.Market,
+		Products:    make([]productDTO, 0, len(job.Products)),
+		FailedPages: job.FailedPages,
+		FinishedAt:  job.FinishedAt,
+	}
+	for _, p := range job.Products {
+		res.Products = append(res.Products, productDTO{
+			Name:            p.Name,
+			Price:           p.Price,
+			Link:            p.URL,
+			ID:              p.ID,
+			SKU:             p.SKU,
+			Brand:           p.Brand,
+			PackSize:        p.PackSize,
+			PackUnit:        p.PackUnit,
+			OriginalPrice:   p.OriginalPrice,
+			DiscountPercent: p.DiscountPercent,
+			InStock:         p.InStock,
+			MaxQuantity:     p.MaxQuantity,
+			ImageURLs:       p.ImageURLs,
+			Rating:          p.Rating,
+		})
+	}
+	if job.Err != nil {
+		res.Error = errMessage(job.Err)
+	}
+
+	return res
+}
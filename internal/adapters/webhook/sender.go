@@ -0,0 +1,112 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+const (
+	HeaderSignature  = "X-Webhook-Signature"
+	HeaderTimestamp  = "X-Webhook-Timestamp"
+	HeaderDeliveryID = "X-Webhook-Delivery"
+)
+
+type sender struct {
+	client     *http.Client
+	secret     []byte
+	errMessage func(error) string
+}
+
+// NewSender подписывает тело запроса HMAC-SHA256 от "<timestamp>.<body>" ключом secret.
+// Получатель проверяет подпись и отбрасывает запросы со старым timestamp.
+// Подключение к loopback и частным адресам запрещено, кроме хостов из allowedHosts, поэтому запросы
+// идут напрямую, без прокси из окружения. errMessage превращает ошибку задачи в сообщение для получателя
+// без внутренних подробностей.
+func NewSender(secret string, timeout time.Duration, allowedHosts []string, errMessage func(error) string) *sender {
+	dialer := &net.Dialer{Timeout: timeout}
+	publicDialer := &net.Dialer{Timeout: timeout, Control: publicOnly}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network string, addr string) (net.Conn, error) {
+		host, _, err := net.SplitHostPort(addr)
+		if err == nil && slices.Contains(allowedHosts, host) {
+			return dialer.DialContext(ctx, network, addr)
+		}
+		return publicDialer.DialContext(ctx, network, addr)
+	}
+
+	return &sender{
+		client:     &http.Client{Timeout: timeout, Transport: transport},
+		secret:     []byte(secret),
+		errMessage: errMessage,
+	}
+}
+
+// publicOnly проверяет адрес после резолва имени, поэтому имя, указывающее на внутренний адрес, тоже отклоняется.
+func publicOnly(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !domain.PublicIP(ip) {
+		return fmt.Errorf("%w: %s is not a public address", domain.ErrInvalidCallbackURL, host)
+	}
+
+	return nil
+}
+
+func (s *sender) Send(ctx context.Context, deliveryID string, url string, job *domain.Job) (int, error) {
+	body, err := json.Marshal(toPayload(deliveryID, job, s.errMessage))
+	if err != nil {
+		return 0, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("new request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderDeliveryID, deliveryID)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, "sha256="+Sign(s.secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Sign возвращает hex HMAC-SHA256 от "<timestamp>.<body>".
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,46 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+func TestSenderSend(t *testing.T) {
+	var got payload
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decode payload: %v", err)
+		}
+	}))
+	defer receiver.Close()
+
+	u, err := url.Parse(receiver.URL)
+	if err != nil {
+		t.Fatalf("parse receiver url: %v", err)
+	}
+	errMessage := func(error) string { return "internal server error" }
+	job := &domain.Job{ID: "job", Status: domain.JobStatusFailed, Err: errors.New(`click "div.secret-selector": node not found`)}
+
+	// получатель слушает loopback: без разрешения подключение отклоняется
+	s := NewSender("secret", time.Second, nil, errMessage)
+	if _, err := s.Send(context.Background(), "delivery", receiver.URL, job); !errors.Is(err, domain.ErrInvalidCallbackURL) {
+		t.Fatalf("send to loopback: got error %v, want %v", err, domain.ErrInvalidCallbackURL)
+	}
+
+	s = NewSender("secret", time.Second, []string{u.Hostname()}, errMessage)
+	status, err := s.Send(context.Background(), "delivery", receiver.URL, job)
+	if err != nil || status != http.StatusOK {
+		t.Fatalf("send to allowed host: got status %d, error %v", status, err)
+	}
+	if got.JobID != "job" || got.Error != "internal server error" {
+		t.Errorf("payload = %+v, want job error without internal details", got)
+	}
+}
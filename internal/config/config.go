@@ -9,19 +9,37 @@ import (
 )
 
 type Config struct {
-	Server  ServerConfig  `yaml:"server"`
-	Browser BrowserConfig `yaml:"browser"`
-	Options OptionsConfig `yaml:"options"`
+	Server    ServerConfig     `yaml:"server"`
+	Providers ProvidersConfig  `yaml:"providers"`
+	Browser   BrowserConfig    `yaml:"browser"`
+	Sessions  SessionsConfig   `yaml:"sessions"`
+	Storage   StorageConfig    `yaml:"storage"`
+	Snapshots SnapshotsConfig  `yaml:"snapshots"`
+	Alerts    AlertsConfig     `yaml:"alerts"`
+	Jobs      JobsConfig       `yaml:"jobs"`
+	Webhooks  WebhooksConfig   `yaml:"webhooks"`
+	Scheduler SchedulerConfig  `yaml:"scheduler"`
+	Schedules []ScheduleConfig `yaml:"schedules"`
+	Options   OptionsConfig    `yaml:"options"`
 }
 
 type ServerConfig struct {
 	Env             string        `yaml:"env" env:"SERVER_ENV" env-required:"true"`
 	HTTPAddr        string        `yaml:"http_addr" env:"SERVER_HTTP_ADDR" env-required:"true"`
-	KuperCfg        KuperConfig   `yaml:"kuper_config"`
 	RequestTimeout  time.Duration `yaml:"request_timeout" env:"SERVER_REQUEST_TIMEOUT" env-default:"180000ms"`
+	StreamTimeout   time.Duration `yaml:"stream_timeout" env:"SERVER_STREAM_TIMEOUT" env-default:"2h"`
+	CatalogCacheTTL time.Duration `yaml:"catalog_cache_ttl" env:"SERVER_CATALOG_CACHE_TTL" env-default:"1h"`
 	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SERVER_SHUTDOWN_TIMEOUT" env-default:"15000ms"`
 }
 
+// ProvidersConfig содержит секции агрегаторов. Default используется, когда в запросе не указан provider.
+// Profiles - пути к yaml-профилям агрегаторов без отдельного адаптера, имя агрегатора берётся из профиля.
+type ProvidersConfig struct {
+	Default  string      `yaml:"default" env:"PROVIDERS_DEFAULT" env-default:"kuper"`
+	Kuper    KuperConfig `yaml:"kuper"`
+	Profiles []string    `yaml:"profiles"`
+}
+
 type BrowserConfig struct {
 	WsURL                   string        `yaml:"ws_url" env:"BROWSER_WS_URL" env-required:"true"`
 	Headless                bool          `yaml:"headless"`
@@ -40,6 +58,80 @@ type BrowserConfig struct {
 	WaitDOMStableDuration   time.Duration `yaml:"wait_dom_stable_duration" env:"BROWSER_WAIT_DOM_STABLE_DURATION" env-default:"300ms"`
 	WaitDOMStableDiff       float64       `yaml:"wait_dom_stable_diff" env:"BROWSER_WAIT_DOM_STABLE_DIFF" env-default:"0.85"`
 	WaitRequestIdleDuration time.Duration `yaml:"wait_request_idle_duration" env:"BROWSER_WAIT_IDLE_DURATION" env-default:"500ms"`
+	ParseWorkers            int           `yaml:"parse_workers" env:"BROWSER_PARSE_WORKERS" env-default:"3"`
+	ParsePageRetries        int           `yaml:"parse_page_retries" env:"BROWSER_PARSE_PAGE_RETRIES" env-default:"2"`
+	Pool                    PoolConfig    `yaml:"pool"`
+	// RecordDir включает запись сессий браузера (html страниц и ответов products) для offline-тестов
+	RecordDir string `yaml:"record_dir" env:"BROWSER_RECORD_DIR"`
+}
+
+type PoolConfig struct {
+	MinSize              int           `yaml:"min_size" env:"BROWSER_POOL_MIN_SIZE" env-default:"1"`
+	MaxSize              int           `yaml:"max_size" env:"BROWSER_POOL_MAX_SIZE" env-default:"2"`
+	HealthCheckInterval  time.Duration `yaml:"health_check_interval" env:"BROWSER_POOL_HEALTH_CHECK_INTERVAL" env-default:"30000ms"`
+	RecycleAfterSessions int           `yaml:"recycle_after_sessions" env:"BROWSER_POOL_RECYCLE_AFTER_SESSIONS" env-default:"20"`
+	RecycleMemoryMB      int           `yaml:"recycle_memory_mb" env:"BROWSER_POOL_RECYCLE_MEMORY_MB" env-default:"512"`
+}
+
+type SessionsConfig struct {
+	Store string        `yaml:"store" env:"SESSIONS_STORE" env-default:"file"`
+	Dir   string        `yaml:"dir" env:"SESSIONS_DIR" env-default:"./data/sessions"`
+	TTL   time.Duration `yaml:"ttl" env:"SESSIONS_TTL" env-default:"24h"`
+}
+
+type StorageConfig struct {
+	SQLitePath  string `yaml:"sqlite_path" env:"STORAGE_SQLITE_PATH" env-default:"./data/market-parser.db"`
+	PostgresDSN string `yaml:"postgres_dsn" env:"STORAGE_POSTGRES_DSN"`
+}
+
+type SnapshotsConfig struct {
+	Store string `yaml:"store" env:"SNAPSHOTS_STORE" env-default:"sqlite"`
+}
+
+type AlertsConfig struct {
+	Store            string        `yaml:"store" env:"ALERTS_STORE" env-default:"sqlite"`
+	NotifyTimeout    time.Duration `yaml:"notify_timeout" env:"ALERTS_NOTIFY_TIMEOUT" env-default:"10000ms"`
+	TelegramBaseURL  string        `yaml:"telegram_base_url" env:"ALERTS_TELEGRAM_BASE_URL" env-default:"https://api.telegram.org"`
+	TelegramBotToken string        `yaml:"telegram_bot_token" env:"ALERTS_TELEGRAM_BOT_TOKEN"`
+}
+
+type JobsConfig struct {
+	Workers   int           `yaml:"workers" env:"JOBS_WORKERS" env-default:"2"`
+	QueueSize int           `yaml:"queue_size" env:"JOBS_QUEUE_SIZE" env-default:"100"`
+	Timeout   time.Duration `yaml:"timeout" env:"JOBS_TIMEOUT" env-default:"600000ms"`
+	Retention time.Duration `yaml:"retention" env:"JOBS_RETENTION" env-default:"1h"`
+}
+
+type WebhooksConfig struct {
+	Secret         string        `yaml:"secret" env:"WEBHOOKS_SECRET"`
+	Timeout        time.Duration `yaml:"timeout" env:"WEBHOOKS_TIMEOUT" env-default:"10000ms"`
+	MaxAttempts    int           `yaml:"max_attempts" env:"WEBHOOKS_MAX_ATTEMPTS" env-default:"5"`
+	InitialBackoff time.Duration `yaml:"initial_backoff" env:"WEBHOOKS_INITIAL_BACKOFF" env-default:"1000ms"`
+	MaxBackoff     time.Duration `yaml:"max_backoff" env:"WEBHOOKS_MAX_BACKOFF" env-default:"60000ms"`
+	LogStore       string        `yaml:"log_store" env:"WEBHOOKS_LOG_STORE" env-default:"memory"`
+	LogLimit       int           `yaml:"log_limit" env:"WEBHOOKS_LOG_LIMIT" env-default:"1000"`
+	AllowedHosts   []string      `yaml:"allowed_hosts" env:"WEBHOOKS_ALLOWED_HOSTS" env-separator:","`
+}
+
+type SchedulerConfig struct {
+	HistoryStore  string        `yaml:"history_store" env:"SCHEDULER_HISTORY_STORE" env-default:"memory"`
+	HistoryLimit  int           `yaml:"history_limit" env:"SCHEDULER_HISTORY_LIMIT" env-default:"50"`
+	TargetTimeout time.Duration `yaml:"target_timeout" env:"SCHEDULER_TARGET_TIMEOUT" env-default:"600000ms"`
+}
+
+type ScheduleConfig struct {
+	Name    string                 `yaml:"name"`
+	Cron    string                 `yaml:"cron"`
+	Jitter  time.Duration          `yaml:"jitter"`
+	Mode    string                 `yaml:"mode"`
+	Targets []ScheduleTargetConfig `yaml:"targets"`
+}
+
+type ScheduleTargetConfig struct {
+	Provider string `yaml:"provider"`
+	Market   string `yaml:"market"`
+	Address  string `yaml:"address"`
+	Category string `yaml:"category"`
 }
 
 type OptionsConfig struct {
@@ -47,21 +139,39 @@ type OptionsConfig struct {
 }
 
 type KuperConfig struct {
-	BaseURL                      *string `yaml:"base_url" env-required:"true"`
-	ApiProductsPath              *string `yaml:"api_products_path" env-required:"true"`
-	CaptchaCheckBox              *string `yaml:"captcha_check_box" env-required:"true"`
-	SmartCaptchaSelector         *string `yaml:"smart_captcha_selector" env-required:"true"`
-	CurrentAddressSelector       *string `yaml:"current_address_selector" env-required:"true"`
-	AddressButtonSelector        *string `yaml:"address_button_selector" env-required:"true"`
-	AddressCheckAttributeValue   *string `yaml:"address_check_attribute_value" env-required:"true"`
-	AddressInputSelector         *string `yaml:"address_input_selector" env-required:"true"`
-	AddressInputDropDownSelector *string `yaml:"address_input_drop_down_selector" env-required:"true"`
-	AddressSaveButtonSelector    *string `yaml:"address_save_button_selector" env-required:"true"`
-	MarketSelector               *string `yaml:"market_selector" env-required:"true"`
-	AllProdsSelector             *string `yaml:"all_prods_selector" env-required:"true"`
-	LastPageSelector             *string `yaml:"last_page_selector" env-required:"true"`
-	LastPageText                 *string `yaml:"last_page_text" env-required:"true"`
-	NextPageSelector             *string `yaml:"next_page_selector" env-required:"true"`
+	BaseURL                      *string       `yaml:"base_url" env-required:"true"`
+	ApiProductsPath              *string       `yaml:"api_products_path" env-required:"true"`
+	ApiCategoriesPath            string        `yaml:"api_categories_path" env-default:"categories"`
+	CaptchaCheckBox              *string       `yaml:"captcha_check_box" env-required:"true"`
+	SmartCaptchaSelector         *string       `yaml:"smart_captcha_selector" env-required:"true"`
+	CurrentAddressSelector       *string       `yaml:"current_address_selector" env-required:"true"`
+	AddressButtonSelector        *string       `yaml:"address_button_selector" env-required:"true"`
+	AddressCheckAttributeValue   *string       `yaml:"address_check_attribute_value" env-required:"true"`
+	AddressInputSelector         *string       `yaml:"address_input_selector" env-required:"true"`
+	AddressInputDropDownSelector *string       `yaml:"address_input_drop_down_selector" env-required:"true"`
+	AddressSaveButtonSelector    *string       `yaml:"address_save_button_selector" env-required:"true"`
+	MarketSelector               *string       `yaml:"market_selector" env-required:"true"`
+	MarketCardSelector           *string       `yaml:"market_card_selector" env-required:"true"`
+	MarketLogoSelector           *string       `yaml:"market_logo_selector" env-required:"true"`
+	MarketDeliverySelector       *string       `yaml:"market_delivery_selector" env-required:"true"`
+	AllProdsSelector             *string       `yaml:"all_prods_selector" env-required:"true"`
+	LastPageSelector             *string       `yaml:"last_page_selector" env-required:"true"`
+	LastPageText                 *string       `yaml:"last_page_text" env-required:"true"`
+	NextPageSelector             *string       `yaml:"next_page_selector" env-required:"true"`
+	Search                       SearchConfig  `yaml:"search"`
+	Mode                         string        `yaml:"mode" env:"KUPER_MODE" env-default:"browser"`
+	ApiTimeout                   time.Duration `yaml:"api_timeout" env:"KUPER_API_TIMEOUT" env-default:"15000ms"`
+}
+
+// SearchConfig описывает страницу поиска магазина. Пустые SortValues/PriceMinParam/PriceMaxParam
+// означают, что магазин не умеет так фильтровать, и выдача фильтруется после парсинга.
+type SearchConfig struct {
+	Path          string            `yaml:"path" env-default:"search"`
+	QueryParam    string            `yaml:"query_param" env-default:"keywords"`
+	SortParam     string            `yaml:"sort_param" env-default:"sort"`
+	SortValues    map[string]string `yaml:"sort_values"`
+	PriceMinParam string            `yaml:"price_min_param"`
+	PriceMaxParam string            `yaml:"price_max_param"`
 }
 
 type ProxyConfig struct {
@@ -0,0 +1,13 @@
+package domain
+
+import "strings"
+
+// NormalizeAddress приводит адрес к виду, по которому сохраняются сессии:
+// нижний регистр, без лишних пробелов и завершающих знаков препинания.
+func NormalizeAddress(address string) string {
+	address = strings.ToLower(address)
+	address = strings.Join(strings.Fields(address), " ")
+	address = strings.TrimRight(address, " .,;")
+
+	return address
+}
@@ -0,0 +1,189 @@
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type AlertKind string
+
+const (
+	// AlertKindPriceDrop - цена упала от предыдущего снимка не меньше чем на Threshold процентов.
+	AlertKindPriceDrop AlertKind = "price_drop"
+	// AlertKindPriceRise - цена выросла от предыдущего снимка не меньше чем на Threshold процентов.
+	AlertKindPriceRise AlertKind = "price_rise"
+	// AlertKindPriceBelow - цена ниже Threshold рублей.
+	AlertKindPriceBelow AlertKind = "price_below"
+)
+
+type AlertNotifier string
+
+const (
+	AlertNotifierWebhook  AlertNotifier = "webhook"
+	AlertNotifierTelegram AlertNotifier = "telegram"
+	AlertNotifierLog      AlertNotifier = "log"
+)
+
+// AlertRule - правило оповещения. Market, Address, Category и ProductID сужают правило,
+// пустые поля подходят под любое значение. ProductID - каноничная ссылка на товар или артикул.
+// Target - url для webhook или chat_id для telegram.
+type AlertRule struct {
+	ID        string
+	Name      string
+	Kind      AlertKind
+	Threshold float64
+	Market    string
+	Address   string
+	Category  string
+	ProductID string
+	Notifier  AlertNotifier
+	Target    string
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (r AlertRule) Validate() error {
+	switch r.Kind {
+	case AlertKindPriceDrop, AlertKindPriceRise, AlertKindPriceBelow:
+	default:
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidAlertRule, r.Kind)
+	}
+	if r.Threshold <= 0 {
+		return fmt.Errorf("%w: threshold must be positive", ErrInvalidAlertRule)
+	}
+
+	switch r.Notifier {
+	case AlertNotifierLog:
+	case AlertNotifierTelegram:
+		if r.Target == "" {
+			return fmt.Errorf("%w: telegram target must be a chat id", ErrInvalidAlertRule)
+		}
+	case AlertNotifierWebhook:
+		u, err := url.Parse(r.Target)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: webhook target must be an http(s) url", ErrInvalidAlertRule)
+		}
+	default:
+		return fmt.Errorf("%w: unknown notifier %q", ErrInvalidAlertRule, r.Notifier)
+	}
+
+	return nil
+}
+
+// AlertEvent - срабатывание правила на товаре снимка. OldPrice и ChangePercent пустые,
+// если предыдущей цены нет. Key определяет товар в категории магазина по адресу, Fingerprint - само условие:
+// пока у Key не меняется Fingerprint, повторное оповещение не отправляется.
+type AlertEvent struct {
+	RuleID        string
+	RuleName      string
+	Kind          AlertKind
+	SnapshotID    string
+	Market        string
+	Address       string
+	Category      string
+	Product       Products
+	OldPrice      *float64
+	NewPrice      float64
+	ChangePercent *float64
+	At            time.Time
+	Key           string
+	Fingerprint   string
+}
+
+// AlertTargetKey - префикс Key событий одной категории магазина по одному адресу в выдаче одного агрегатора.
+func AlertTargetKey(provider string, market string, address string, category string) string {
+	return provider + "|" + market + "|" + NormalizeAddress(address) + "|" + category + "|"
+}
+
+// Evaluate проверяет правило на снимке cur относительно предыдущего снимка той же категории prev.
+// prev может быть nil, тогда проверяются только правила без сравнения цен.
+func (r AlertRule) Evaluate(prev *Snapshot, cur *Snapshot) []AlertEvent {
+	if !r.Enabled || !r.matchesTarget(cur) {
+		return nil
+	}
+
+	prevByKey := map[string]Products{}
+	if prev != nil {
+		for _, p := range prev.Products {
+			prevByKey[productKey(p)] = p
+		}
+	}
+
+	res := []AlertEvent{}
+	for _, p := range cur.Products {
+		if !r.matchesProduct(p) {
+			continue
+		}
+		key := productKey(p)
+
+		event := AlertEvent{
+			RuleID:     r.ID,
+			RuleName:   r.Name,
+			Kind:       r.Kind,
+			SnapshotID: cur.ID,
+			Market:     cur.Market,
+			Address:    cur.Address,
+			Category:   cur.Category,
+			Product:    p,
+			NewPrice:   p.Price,
+			At:         cur.CreatedAt,
+			Key:        AlertTargetKey(cur.Provider, cur.Market, cur.Address, cur.Category) + key,
+		}
+		old, ok := prevByKey[key]
+		if ok {
+			event.OldPrice = &old.Price
+			if old.Price != 0 {
+				change := roundCents((p.Price - old.Price) / old.Price * 100)
+				event.ChangePercent = &change
+			}
+		}
+
+		switch r.Kind {
+		case AlertKindPriceBelow:
+			if p.Price >= r.Threshold {
+				continue
+			}
+			// ниже порога - одно условие, пока цена не поднимется обратно
+			event.Fingerprint = "below"
+		case AlertKindPriceDrop:
+			if event.ChangePercent == nil || -*event.ChangePercent < r.Threshold {
+				continue
+			}
+			event.Fingerprint = fmt.Sprintf("%.2f->%.2f", old.Price, p.Price)
+		case AlertKindPriceRise:
+			if event.ChangePercent == nil || *event.ChangePercent < r.Threshold {
+				continue
+			}
+			event.Fingerprint = fmt.Sprintf("%.2f->%.2f", old.Price, p.Price)
+		}
+		res = append(res, event)
+	}
+
+	return res
+}
+
+func (r AlertRule) matchesTarget(s *Snapshot) bool {
+	if r.Market != "" && r.Market != s.Market {
+		return false
+	}
+	if r.Address != "" && NormalizeAddress(r.Address) != NormalizeAddress(s.Address) {
+		return false
+	}
+	if r.Category != "" && !strings.EqualFold(r.Category, s.Category) {
+		return false
+	}
+	return true
+}
+
+func (r AlertRule) matchesProduct(p Products) bool {
+	if r.ProductID == "" {
+		return true
+	}
+	if p.SKU != nil && *p.SKU == r.ProductID {
+		return true
+	}
+	return CanonicalProductURL(p.URL) == CanonicalProductURL(r.ProductID)
+}
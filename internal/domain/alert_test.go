@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAlertRuleEvaluate(t *testing.T) {
+	sku := func(v string) *string { return &v }
+	snapshot := func(products ...Products) *Snapshot {
+		return &Snapshot{ID: "cur", Provider: "kuper", Market: "metro", Address: "Москва, Тверская улица, 1", Category: "Молоко", Products: products}
+	}
+	rule := func(kind AlertKind, threshold float64) AlertRule {
+		return AlertRule{ID: "rule", Kind: kind, Threshold: threshold, Notifier: AlertNotifierLog, Enabled: true}
+	}
+
+	tests := []struct {
+		name   string
+		rule   AlertRule
+		modify func(r *AlertRule)
+		prev   *Snapshot
+		cur    *Snapshot
+
+		// "товар:fingerprint" сработавших событий в порядке снимка
+		want []string
+	}{
+		{
+			name: "disabled",
+			rule: rule(AlertKindPriceBelow, 100),
+			modify: func(r *AlertRule) {
+				r.Enabled = false
+			},
+			cur: snapshot(product("milk", 50)),
+		},
+		{
+			name: "price below",
+			rule: rule(AlertKindPriceBelow, 100),
+			cur:  snapshot(product("milk", 99.99), product("kefir", 100), product("cream", 150)),
+			want: []string{"milk:below"},
+		},
+		{
+			name: "price below without previous snapshot",
+			rule: rule(AlertKindPriceBelow, 100),
+			prev: nil,
+			cur:  snapshot(product("milk", 50)),
+			want: []string{"milk:below"},
+		},
+		{
+			name: "price drop",
+			rule: rule(AlertKindPriceDrop, 10),
+			prev: snapshot(product("milk", 100), product("kefir", 100), product("cream", 100)),
+			cur:  snapshot(product("milk", 90), product("kefir", 90.01), product("cream", 110)),
+			want: []string{"milk:100.00->90.00"},
+		},
+		{
+			name: "price drop without previous snapshot",
+			rule: rule(AlertKindPriceDrop, 10),
+			cur:  snapshot(product("milk", 50)),
+		},
+		{
+			name: "price drop of new product",
+			rule: rule(AlertKindPriceDrop, 10),
+			prev: snapshot(product("kefir", 100)),
+			cur:  snapshot(product("milk", 50)),
+		},
+		{
+			name: "price drop from zero price",
+			rule: rule(AlertKindPriceDrop, 10),
+			prev: snapshot(product("milk", 0)),
+			cur:  snapshot(product("milk", 50)),
+		},
+		{
+			name: "price rise",
+			rule: rule(AlertKindPriceRise, 5),
+			prev: snapshot(product("milk", 100), product("kefir", 100)),
+			cur:  snapshot(product("milk", 105), product("kefir", 104.99)),
+			want: []string{"milk:100.00->105.00"},
+		},
+		{
+			name: "market mismatch",
+			rule: rule(AlertKindPriceBelow, 100),
+			modify: func(r *AlertRule) {
+				r.Market = "auchan"
+			},
+			cur: snapshot(product("milk", 50)),
+		},
+		{
+			name: "address and category match after normalization",
+			rule: rule(AlertKindPriceBelow, 100),
+			modify: func(r *AlertRule) {
+				r.Market = "metro"
+				r.Address = "  москва,  тверская улица, 1 "
+				r.Category = "молоко"
+			},
+			cur:  snapshot(product("milk", 50)),
+			want: []string{"milk:below"},
+		},
+		{
+			name: "category mismatch",
+			rule: rule(AlertKindPriceBelow, 100),
+			modify: func(r *AlertRule) {
+				r.Category = "Сыр"
+			},
+			cur: snapshot(product("milk", 50)),
+		},
+		{
+			name: "product by canonical url",
+			rule: rule(AlertKindPriceBelow, 100),
+			modify: func(r *AlertRule) {
+				r.ProductID = "https://kuper.ru/metro/milk/?sid=1"
+			},
+			cur:  snapshot(product("milk", 50), product("kefir", 50)),
+			want: []string{"milk:below"},
+		},
+		{
+			name: "product by sku",
+			rule: rule(AlertKindPriceBelow, 100),
+			modify: func(r *AlertRule) {
+				r.ProductID = "12345"
+			},
+			cur:  snapshot(Products{Name: "milk", Price: 50, URL: "https://kuper.ru/metro/milk", SKU: sku("12345")}, product("kefir", 50)),
+			want: []string{"milk:below"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := tt.rule
+			if tt.modify != nil {
+				tt.modify(&r)
+			}
+
+			got := []string{}
+			for _, e := range r.Evaluate(tt.prev, tt.cur) {
+				got = append(got, e.Product.Name+":"+e.Fingerprint)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("events = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlertRuleEvaluateEvent(t *testing.T) {
+	r := AlertRule{ID: "rule", Name: "milk drop", Kind: AlertKindPriceDrop, Threshold: 10, Notifier: AlertNotifierLog, Enabled: true}
+	prev := &Snapshot{Market: "metro", Address: "Москва, Тверская улица, 1", Category: "Молоко", Products: []Products{
+		{Name: "milk", Price: 120, URL: "https://kuper.ru/metro/milk"},
+	}}
+	cur := &Snapshot{ID: "cur", Provider: "kuper", Market: "metro", Address: "Москва, Тверская улица, 1", Category: "Молоко", Products: []Products{
+		{Name: "milk", Price: 90, URL: "https://kuper.ru/metro/milk/?sid=1"},
+	}}
+
+	events := r.Evaluate(prev, cur)
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+
+	e := events[0]
+	if e.RuleID != "rule" || e.RuleName != "milk drop" || e.Kind != AlertKindPriceDrop || e.SnapshotID != "cur" {
+		t.Errorf("event = %+v", e)
+	}
+	if e.OldPrice == nil || *e.OldPrice != 120 || e.NewPrice != 90 {
+		t.Errorf("old price = %v, new price = %v, want 120 -> 90", e.OldPrice, e.NewPrice)
+	}
+	if e.ChangePercent == nil || *e.ChangePercent != -25 {
+		t.Errorf("change percent = %v, want -25", e.ChangePercent)
+	}
+	if want := AlertTargetKey("kuper", "metro", "Москва, Тверская улица, 1", "Молоко") + "https://kuper.ru/metro/milk"; e.Key != want {
+		t.Errorf("key = %q, want %q", e.Key, want)
+	}
+}
@@ -0,0 +1,53 @@
+package domain
+
+import "time"
+
+type SelectorStatus string
+
+const (
+	SelectorFound      SelectorStatus = "found"
+	SelectorMissing    SelectorStatus = "missing"
+	SelectorNotVisible SelectorStatus = "not_visible"
+	// SelectorSkipped - страница селектора не открылась из-за ошибки предыдущей проверки.
+	SelectorSkipped SelectorStatus = "skipped"
+)
+
+// SelectorCheck - результат проверки одного селектора из конфигурации агрегатора.
+// Required=false у селекторов, которые есть на странице не всегда (капча, пагинация).
+// Screenshot - PNG страницы, если обязательный селектор не найден или не виден.
+type SelectorCheck struct {
+	Name       string
+	Selector   string
+	Page       string
+	Required   bool
+	Status     SelectorStatus
+	Duration   time.Duration
+	Err        string
+	Screenshot []byte
+}
+
+func (c SelectorCheck) Failed() bool {
+	return c.Required && c.Status != SelectorFound
+}
+
+// SelectorReport - итог проверки селекторов агрегатора для магазина и адреса доставки.
+type SelectorReport struct {
+	Provider  string
+	Market    string
+	Address   string
+	Category  string
+	StartedAt time.Time
+	Duration  time.Duration
+	Checks    []SelectorCheck
+}
+
+// Healthy - все обязательные селекторы найдены и видны.
+func (r *SelectorReport) Healthy() bool {
+	for _, c := range r.Checks {
+		if c.Failed() {
+			return false
+		}
+	}
+
+	return true
+}
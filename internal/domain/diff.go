@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// PriceChange - изменение цены товара между двумя выдачами. DeltaPercent считается от старой цены
+// и равен 0, если старая цена нулевая.
+type PriceChange struct {
+	Product      Products
+	OldPrice     float64
+	NewPrice     float64
+	Delta        float64
+	DeltaPercent float64
+}
+
+// StockChange - изменение наличия товара. Товары без признака наличия в одной из выдач не сравниваются.
+type StockChange struct {
+	Product    Products
+	WasInStock bool
+	InStock    bool
+}
+
+// SnapshotDiff - разница между двумя выдачами одной категории. From и To заполняются,
+// если сравнивались сохранённые снимки.
+type SnapshotDiff struct {
+	From         *Snapshot
+	To           *Snapshot
+	Added        []Products
+	Removed      []Products
+	PriceUp      []PriceChange
+	PriceDown    []PriceChange
+	StockChanged []StockChange
+}
+
+// DiffProducts сравнивает две выдачи по каноничной ссылке товара, а без ссылки - по артикулу.
+// Повторы товара в выдаче (перезапрошенная страница) не учитываются: сравнивается первое вхождение,
+// как и в истории цен. Подорожания и подешевления упорядочены по убыванию изменения в процентах.
+func DiffProducts(before []Products, after []Products) *SnapshotDiff {
+	res := &SnapshotDiff{
+		Added:        []Products{},
+		Removed:      []Products{},
+		PriceUp:      []PriceChange{},
+		PriceDown:    []PriceChange{},
+		StockChanged: []StockChange{},
+	}
+
+	before, after = uniqueProducts(before), uniqueProducts(after)
+
+	oldByKey := make(map[string]Products, len(before))
+	for _, p := range before {
+		oldByKey[productKey(p)] = p
+	}
+	newKeys := make(map[string]struct{}, len(after))
+
+	for _, p := range after {
+		key := productKey(p)
+		newKeys[key] = struct{}{}
+
+		prev, ok := oldByKey[key]
+		if !ok {
+			res.Added = append(res.Added, p)
+			continue
+		}
+
+		if p.Price != prev.Price {
+			change := PriceChange{
+				Product:  p,
+				OldPrice: prev.Price,
+				NewPrice: p.Price,
+				Delta:    roundCents(p.Price - prev.Price),
+			}
+			if prev.Price != 0 {
+				change.DeltaPercent = roundCents((p.Price - prev.Price) / prev.Price * 100)
+			}
+			if p.Price > prev.Price {
+				res.PriceUp = append(res.PriceUp, change)
+			} else {
+				res.PriceDown = append(res.PriceDown, change)
+			}
+		}
+
+		if p.InStock != nil && prev.InStock != nil && *p.InStock != *prev.InStock {
+			res.StockChanged = append(res.StockChanged, StockChange{Product: p, WasInStock: *prev.InStock, InStock: *p.InStock})
+		}
+	}
+
+	for _, p := range before {
+		if _, ok := newKeys[productKey(p)]; !ok {
+			res.Removed = append(res.Removed, p)
+		}
+	}
+
+	slices.SortStableFunc(res.PriceUp, func(a, b PriceChange) int {
+		return cmp.Compare(b.DeltaPercent, a.DeltaPercent)
+	})
+	slices.SortStableFunc(res.PriceDown, func(a, b PriceChange) int {
+		return cmp.Compare(a.DeltaPercent, b.DeltaPercent)
+	})
+
+	return res
+}
+
+// uniqueProducts оставляет первое по порядку вхождение каждого товара.
+func uniqueProducts(products []Products) []Products {
+	seen := make(map[string]struct{}, len(products))
+	res := make([]Products, 0, len(products))
+	for _, p := range products {
+		key := productKey(p)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		res = append(res, p)
+	}
+
+	return res
+}
+
+func productKey(p Products) string {
+	if key := CanonicalProductURL(p.URL); key != "" {
+		return key
+	}
+	if p.SKU != nil {
+		return "sku:" + *p.SKU
+	}
+	return "name:" + p.Name
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
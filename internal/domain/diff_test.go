@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDiffProducts(t *testing.T) {
+	inStock := func(v bool) *bool { return &v }
+
+	tests := []struct {
+		name   string
+		before []Products
+		after  []Products
+
+		added        []string
+		removed      []string
+		priceUp      []PriceChange
+		priceDown    []PriceChange
+		stockChanged []StockChange
+	}{
+		{
+			name:   "unchanged",
+			before: []Products{product("milk", 100)},
+			after:  []Products{product("milk", 100)},
+		},
+		{
+			name:   "added",
+			before: []Products{product("milk", 100)},
+			after:  []Products{product("milk", 100), product("bread", 50)},
+			added:  []string{"bread"},
+		},
+		{
+			name:    "removed",
+			before:  []Products{product("milk", 100), product("bread", 50)},
+			after:   []Products{product("milk", 100)},
+			removed: []string{"bread"},
+		},
+		{
+			name:    "price up",
+			before:  []Products{product("milk", 100)},
+			after:   []Products{product("milk", 110)},
+			priceUp: []PriceChange{{OldPrice: 100, NewPrice: 110, Delta: 10, DeltaPercent: 10}},
+		},
+		{
+			name:      "price down",
+			before:    []Products{product("milk", 100)},
+			after:     []Products{product("milk", 75.5)},
+			priceDown: []PriceChange{{OldPrice: 100, NewPrice: 75.5, Delta: -24.5, DeltaPercent: -24.5}},
+		},
+		{
+			// изменение меньше полкопейки округляется до 0, но остаётся подорожанием
+			name:    "sub-cent rise",
+			before:  []Products{product("milk", 100)},
+			after:   []Products{product("milk", 100.004)},
+			priceUp: []PriceChange{{OldPrice: 100, NewPrice: 100.004, Delta: 0, DeltaPercent: 0}},
+		},
+		{
+			name:      "sub-cent drop",
+			before:    []Products{product("milk", 100)},
+			after:     []Products{product("milk", 99.996)},
+			priceDown: []PriceChange{{OldPrice: 100, NewPrice: 99.996, Delta: 0, DeltaPercent: 0}},
+		},
+		{
+			name:    "price up from zero",
+			before:  []Products{product("milk", 0)},
+			after:   []Products{product("milk", 10)},
+			priceUp: []PriceChange{{OldPrice: 0, NewPrice: 10, Delta: 10, DeltaPercent: 0}},
+		},
+		{
+			name: "stock change",
+			before: []Products{
+				{Name: "milk", Price: 100, URL: "https://kuper.ru/metro/milk", InStock: inStock(true)},
+				{Name: "bread", Price: 50, URL: "https://kuper.ru/metro/bread"},
+			},
+			after: []Products{
+				{Name: "milk", Price: 100, URL: "https://kuper.ru/metro/milk", InStock: inStock(false)},
+				{Name: "bread", Price: 50, URL: "https://kuper.ru/metro/bread", InStock: inStock(false)},
+			},
+			stockChanged: []StockChange{{WasInStock: true, InStock: false}},
+		},
+		{
+			name:   "same product by canonical url",
+			before: []Products{{Name: "milk", Price: 100, URL: "https://kuper.ru/metro/milk/?sid=1"}},
+			after:  []Products{{Name: "milk", Price: 100, URL: "https://kuper.ru/metro/milk"}},
+		},
+		{
+			// повтор страницы: сравнивается первое вхождение, товар не попадает в изменения дважды
+			name:      "duplicates keep first",
+			before:    []Products{product("milk", 100), product("bread", 50), product("milk", 80), product("cream", 70), product("cream", 70)},
+			after:     []Products{product("milk", 90), product("milk", 120), product("kefir", 60), product("kefir", 60)},
+			added:     []string{"kefir"},
+			removed:   []string{"bread", "cream"},
+			priceDown: []PriceChange{{OldPrice: 100, NewPrice: 90, Delta: -10, DeltaPercent: -10}},
+		},
+		{
+			name:    "price up ordered by percent",
+			before:  []Products{product("milk", 100), product("bread", 50)},
+			after:   []Products{product("milk", 105), product("bread", 55)},
+			priceUp: []PriceChange{{OldPrice: 50, NewPrice: 55, Delta: 5, DeltaPercent: 10}, {OldPrice: 100, NewPrice: 105, Delta: 5, DeltaPercent: 5}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := DiffProducts(tt.before, tt.after)
+
+			if got := names(res.Added); !slices.Equal(got, tt.added) {
+				t.Errorf("added = %v, want %v", got, tt.added)
+			}
+			if got := names(res.Removed); !slices.Equal(got, tt.removed) {
+				t.Errorf("removed = %v, want %v", got, tt.removed)
+			}
+			checkPriceChanges(t, "price up", res.PriceUp, tt.priceUp)
+			checkPriceChanges(t, "price down", res.PriceDown, tt.priceDown)
+
+			if len(res.StockChanged) != len(tt.stockChanged) {
+				t.Fatalf("stock changed = %+v, want %+v", res.StockChanged, tt.stockChanged)
+			}
+			for i, want := range tt.stockChanged {
+				got := res.StockChanged[i]
+				if got.WasInStock != want.WasInStock || got.InStock != want.InStock {
+					t.Errorf("stock changed[%d] = %+v, want %+v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func checkPriceChanges(t *testing.T, kind string, got []PriceChange, want []PriceChange) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("%s = %+v, want %+v", kind, got, want)
+	}
+	for i := range want {
+		g, w := got[i], want[i]
+		if g.OldPrice != w.OldPrice || g.NewPrice != w.NewPrice || g.Delta != w.Delta || g.DeltaPercent != w.DeltaPercent {
+			t.Errorf("%s[%d] = %+v, want %+v", kind, i, g, w)
+		}
+	}
+}
+
+func names(products []Products) []string {
+	res := []string{}
+	for _, p := range products {
+		res = append(res, p.Name)
+	}
+	return res
+}
@@ -1,7 +1,79 @@
 package domain
 
+import "time"
+
+// Products - товар из списка категории. Поля-указатели и ImageURLs пустые, если kuper их не прислал.
+// Price - текущая цена с учётом скидки, OriginalPrice - цена до скидки.
 type Products struct {
 	Name  string
 	Price float64
 	URL   string
+
+	ID              *string
+	SKU             *string
+	Brand           *string
+	PackSize        *float64
+	PackUnit        *string
+	OriginalPrice   *float64
+	DiscountPercent *float64
+	InStock         *bool
+	MaxQuantity     *int
+	ImageURLs       []string
+	Rating          *float64
+}
+
+// Market - магазин, доступный на kuper для адреса доставки. Slug передаётся в параметр market.
+type Market struct {
+	Slug         string
+	Name         string
+	LogoURL      string
+	DeliveryInfo string
+}
+
+type Category struct {
+	ID       int64
+	Title    string
+	Slug     string
+	URL      string
+	Children []Category
+}
+
+// MarketProducts - результат парсинга одного магазина при параллельном обходе нескольких магазинов.
+type MarketProducts struct {
+	Market   string
+	Products []Products
+	Err      error
+}
+
+type ParseMode string
+
+const (
+	ParseModeBrowser ParseMode = "browser"
+	ParseModeAPI     ParseMode = "api"
+)
+
+type Cookie struct {
+	Name     string
+	Value    string
+	Domain   string
+	Path     string
+	Expires  time.Time
+	HTTPOnly bool
+	Secure   bool
+}
+
+type CapturedRequest struct {
+	URL     string
+	Headers map[string]string
+	Cookies []Cookie
+}
+
+// AddressSession - cookies и localStorage магазина с уже сохранённым адресом доставки.
+type AddressSession struct {
+	Market       string
+	Address      string
+	Cookies      []Cookie
+	LocalStorage map[string]string
+	SavedAt      time.Time
+	ExpiresAt    time.Time
 }
@@ -1,11 +1,67 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrEmptyCategory       = errors.New("empty category")
 	ErrEmptyAddress        = errors.New("empty address")
 	ErrEmptyMarket         = errors.New("empty market")
+	ErrEmptyQuery          = errors.New("empty query")
+	ErrUnknownSearchSort   = errors.New("unknown search sort")
+	ErrInvalidPriceRange   = errors.New("invalid price range")
+	ErrUnknownParseMode    = errors.New("unknown parse mode")
+	ErrUnknownProvider     = errors.New("unknown provider")
+	ErrNotSupported        = errors.New("not supported by provider")
+	ErrAPIChallenge        = errors.New("api challenge")
+	ErrCaptchaBlocked      = errors.New("captcha blocked")
+	ErrSessionNotFound     = errors.New("session not found")
 	ErrGatewayTimeout      = errors.New("gateway timeout")
 	ErrClientClosedRequest = errors.New("client closed request")
+	ErrJobNotFound         = errors.New("job not found")
+	ErrJobQueueFull        = errors.New("job queue full")
+	ErrJobQueueClosed      = errors.New("job queue closed")
+	ErrInvalidCallbackURL  = errors.New("invalid callback url")
+	ErrDeliveryNotFound    = errors.New("delivery not found")
+	ErrScheduleNotFound    = errors.New("schedule not found")
+	ErrSnapshotNotFound    = errors.New("snapshot not found")
+	ErrEmptyProductID      = errors.New("empty product id")
+	ErrInvalidTimeRange    = errors.New("invalid time range")
+	ErrPriceHistoryEmpty   = errors.New("price history empty")
+	ErrSnapshotsMismatch   = errors.New("snapshots of different targets")
+	ErrInvalidAlertRule    = errors.New("invalid alert rule")
+	ErrAlertRuleNotFound   = errors.New("alert rule not found")
+	ErrUnknownExportFormat = errors.New("unknown export format")
 )
+
+// PagesError - страницы выдачи, которые не удалось разобрать за все повторы. Товары остальных страниц
+// возвращаются вместе с ошибкой.
+type PagesError struct {
+	// Pages - номера страниц по возрастанию
+	Pages []int
+	Errs  []error
+}
+
+func (e *PagesError) Add(pageNum int, err error) {
+	e.Pages = append(e.Pages, pageNum)
+	e.Errs = append(e.Errs, err)
+}
+
+func (e *PagesError) Error() string {
+	return fmt.Sprintf("pages %v failed: %v", e.Pages, errors.Join(e.Errs...))
+}
+
+func (e *PagesError) Unwrap() []error {
+	return e.Errs
+}
+
+// FailedPages возвращает номера страниц *PagesError из цепочки err. Для других ошибок возвращает nil.
+func FailedPages(err error) []int {
+	var pagesErr *PagesError
+	if errors.As(err, &pagesErr) {
+		return pagesErr.Pages
+	}
+	return nil
+}
@@ -0,0 +1,6 @@
+package domain
+
+// product - товар магазина metro со ссылкой по названию, общий для тестов пакета.
+func product(name string, price float64) Products {
+	return Products{Name: name, Price: price, URL: "https://kuper.ru/metro/" + name}
+}
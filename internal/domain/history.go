@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"net/url"
+	"strings"
+	"time"
+)
+
+// PriceHistoryFilter - выборка цен товара из снимков одного агрегатора. ProductID - каноничная ссылка или артикул,
+// нулевые From и To не ограничивают окно.
+type PriceHistoryFilter struct {
+	ProductID string
+	Provider  string
+	Market    string
+	Address   string
+	From      time.Time
+	To        time.Time
+}
+
+func (f PriceHistoryFilter) Validate() error {
+	if strings.TrimSpace(f.ProductID) == "" {
+		return ErrEmptyProductID
+	}
+	if f.Market == "" {
+		return ErrEmptyMarket
+	}
+	if f.Address == "" {
+		return ErrEmptyAddress
+	}
+	if !f.From.IsZero() && !f.To.IsZero() && f.From.After(f.To) {
+		return ErrInvalidTimeRange
+	}
+
+	return nil
+}
+
+// PricePoint - цена товара в одном снимке.
+type PricePoint struct {
+	SnapshotID string
+	At         time.Time
+	Name       string
+	URL        string
+	SKU        *string
+	Price      float64
+	InStock    *bool
+}
+
+// PriceHistory - цены товара по времени и их статистика за окно выборки.
+type PriceHistory struct {
+	ProductID string
+	Name      string
+	URL       string
+	SKU       *string
+	Provider  string
+	Market    string
+	Address   string
+	Min       float64
+	Max       float64
+	Avg       float64
+	Points    []PricePoint
+}
+
+// NewPriceHistory считает статистику по точкам, упорядоченным по времени.
+// Название и ссылка берутся из последней точки.
+func NewPriceHistory(filter PriceHistoryFilter, points []PricePoint) *PriceHistory {
+	res := &PriceHistory{
+		ProductID: filter.ProductID,
+		Provider:  filter.Provider,
+		Market:    filter.Market,
+		Address:   filter.Address,
+		Points:    points,
+	}
+	if len(points) == 0 {
+		return res
+	}
+
+	last := points[len(points)-1]
+	res.Name, res.URL, res.SKU = last.Name, last.URL, last.SKU
+
+	res.Min, res.Max = points[0].Price, points[0].Price
+	sum := 0.0
+	for _, p := range points {
+		res.Min = min(res.Min, p.Price)
+		res.Max = max(res.Max, p.Price)
+		sum += p.Price
+	}
+	res.Avg = sum / float64(len(points))
+
+	return res
+}
+
+// CanonicalProductURL убирает из ссылки на товар параметры запроса, якорь и завершающий слэш,
+// чтобы один товар из разных выдач сохранялся под одной ссылкой.
+func CanonicalProductURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return raw
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = ""
+
+	return u.String()
+}
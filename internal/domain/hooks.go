@@ -0,0 +1,52 @@
+package domain
+
+import "context"
+
+type parseHooksKey struct{}
+
+// ParseHooks сообщают о ходе парсинга тому, кто его запустил. Передаются через context,
+// поэтому парсеры вызывают их, не зная, кто слушает. Функции могут вызываться из нескольких горутин.
+type ParseHooks struct {
+	// OnPages - стало известно число страниц. При парсинге нескольких market вызывается для каждого.
+	OnPages func(total int)
+	// OnPage - страница pageNum разобрана.
+	OnPage func(pageNum int, products []Products)
+	// OnProduct - товар перехвачен, вызывается до завершения страницы.
+	// Товары страницы, которую парсер перезапросил после ошибки, могут прийти повторно.
+	OnProduct func(product Products)
+	// Stream - товары нужны только в OnProduct, парсер не копит их в результате.
+	Stream bool
+}
+
+func WithParseHooks(ctx context.Context, hooks *ParseHooks) context.Context {
+	return context.WithValue(ctx, parseHooksKey{}, hooks)
+}
+
+// ParseHooksFromContext возвращает hooks из ctx или nil. Методы ParseHooks безопасны для nil.
+func ParseHooksFromContext(ctx context.Context) *ParseHooks {
+	hooks, _ := ctx.Value(parseHooksKey{}).(*ParseHooks)
+	return hooks
+}
+
+func (h *ParseHooks) Pages(total int) {
+	if h != nil && h.OnPages != nil {
+		h.OnPages(total)
+	}
+}
+
+func (h *ParseHooks) Page(pageNum int, products []Products) {
+	if h != nil && h.OnPage != nil {
+		h.OnPage(pageNum, products)
+	}
+}
+
+func (h *ParseHooks) Product(product Products) {
+	if h != nil && h.OnProduct != nil {
+		h.OnProduct(product)
+	}
+}
+
+// Streaming - результат парсинга не нужен, товары уже переданы через OnProduct.
+func (h *ParseHooks) Streaming() bool {
+	return h != nil && h.Stream
+}
@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+type JobStatus string
+
+const (
+	JobStatusQueued   JobStatus = "queued"
+	JobStatusRunning  JobStatus = "running"
+	JobStatusDone     JobStatus = "done"
+	JobStatusFailed   JobStatus = "failed"
+	JobStatusCanceled JobStatus = "canceled"
+)
+
+// Finished - задача больше не изменится.
+func (s JobStatus) Finished() bool {
+	return s == JobStatusDone || s == JobStatusFailed || s == JobStatusCanceled
+}
+
+// JobParams - параметры парсинга категории, переданные при создании задачи.
+// Если задан CallbackURL, результат завершённой задачи отправляется на него.
+// Пустой Provider означает агрегатор по умолчанию.
+type JobParams struct {
+	Provider    string
+	Category    string
+	Address     string
+	Market      string
+	Mode        ParseMode
+	CallbackURL string
+}
+
+// Job - снимок состояния асинхронной задачи парсинга. FailedPages - страницы, которые не удалось
+// разобрать: задача завершается со статусом done и товарами остальных страниц.
+type Job struct {
+	ID          string
+	Params      JobParams
+	Status      JobStatus
+	PagesDone   int
+	PagesTotal  int
+	Products    []Products
+	FailedPages []int
+	Err         error
+	CreatedAt   time.Time
+	StartedAt   time.Time
+	FinishedAt  time.Time
+}
@@ -0,0 +1,59 @@
+package domain
+
+import "time"
+
+// ScheduleTarget - одна комбинация (market, address, category), которую парсит расписание.
+// Пустой Provider означает агрегатор по умолчанию.
+type ScheduleTarget struct {
+	Provider string
+	Market   string
+	Address  string
+	Category string
+}
+
+// Schedule - регулярный парсинг списка целей по cron-выражению.
+// Запуск откладывается на случайную паузу до Jitter, чтобы расписания не стартовали одновременно.
+type Schedule struct {
+	Name    string
+	Cron    string
+	Jitter  time.Duration
+	Mode    ParseMode
+	Targets []ScheduleTarget
+}
+
+type ScheduleRunStatus string
+
+const (
+	ScheduleRunRunning ScheduleRunStatus = "running"
+	ScheduleRunSuccess ScheduleRunStatus = "success"
+	// ScheduleRunPartial - часть целей завершилась ошибкой.
+	ScheduleRunPartial ScheduleRunStatus = "partial"
+	ScheduleRunFailed  ScheduleRunStatus = "failed"
+	// ScheduleRunSkipped - предыдущий запуск расписания ещё не закончился.
+	ScheduleRunSkipped ScheduleRunStatus = "skipped"
+)
+
+type ScheduleTargetResult struct {
+	Target   ScheduleTarget
+	Products int
+	Err      string
+	Duration time.Duration
+}
+
+// ScheduleRun - итог одного запуска расписания.
+type ScheduleRun struct {
+	ID         string
+	Schedule   string
+	Status     ScheduleRunStatus
+	Targets    []ScheduleTargetResult
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// ScheduleStatus - состояние расписания для мониторинга.
+type ScheduleStatus struct {
+	Schedule Schedule
+	Running  bool
+	NextRun  time.Time
+	LastRun  *ScheduleRun
+}
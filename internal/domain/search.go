@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+type SearchSort string
+
+const (
+	SearchSortRelevance SearchSort = "relevance"
+	SearchSortPriceAsc  SearchSort = "price_asc"
+	SearchSortPriceDesc SearchSort = "price_desc"
+	SearchSortDiscount  SearchSort = "discount"
+)
+
+// SearchFilter - параметры поиска товаров по названию. Пустые PriceMin/PriceMax не ограничивают цену.
+type SearchFilter struct {
+	Query    string
+	PriceMin *float64
+	PriceMax *float64
+	Sort     SearchSort
+}
+
+// SearchCategory - категория снимка выдачи поиска. Префикс не даёт спутать выдачу с одноимённой категорией каталога.
+func SearchCategory(query string) string {
+	return "search:" + strings.TrimSpace(query)
+}
+
+func (f *SearchFilter) Validate() error {
+	if strings.TrimSpace(f.Query) == "" {
+		return ErrEmptyQuery
+	}
+
+	switch f.Sort {
+	case "", SearchSortRelevance, SearchSortPriceAsc, SearchSortPriceDesc, SearchSortDiscount:
+	default:
+		return ErrUnknownSearchSort
+	}
+
+	if f.PriceMin != nil && *f.PriceMin < 0 || f.PriceMax != nil && *f.PriceMax < 0 {
+		return ErrInvalidPriceRange
+	}
+	if f.PriceMin != nil && f.PriceMax != nil && *f.PriceMin > *f.PriceMax {
+		return ErrInvalidPriceRange
+	}
+
+	return nil
+}
+
+// Apply отбрасывает товары вне диапазона цен и сортирует оставшиеся.
+// Применяется после магазина, даже если он уже отфильтровал выдачу сам: повторная фильтрация ничего не меняет.
+func (f *SearchFilter) Apply(products []Products) []Products {
+	res := make([]Products, 0, len(products))
+	for _, p := range products {
+		if f.PriceMin != nil && p.Price < *f.PriceMin {
+			continue
+		}
+		if f.PriceMax != nil && p.Price > *f.PriceMax {
+			continue
+		}
+		res = append(res, p)
+	}
+
+	// при сортировке по релевантности сохраняем порядок выдачи магазина
+	switch f.Sort {
+	case SearchSortPriceAsc:
+		slices.SortStableFunc(res, func(a, b Products) int { return cmp.Compare(a.Price, b.Price) })
+	case SearchSortPriceDesc:
+		slices.SortStableFunc(res, func(a, b Products) int { return cmp.Compare(b.Price, a.Price) })
+	case SearchSortDiscount:
+		slices.SortStableFunc(res, func(a, b Products) int { return cmp.Compare(discount(b), discount(a)) })
+	}
+
+	return res
+}
+
+func discount(p Products) float64 {
+	if p.DiscountPercent == nil {
+		return 0
+	}
+	return *p.DiscountPercent
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type SnapshotSource string
+
+const (
+	SnapshotSourceHTTP     SnapshotSource = "http"
+	SnapshotSourceJob      SnapshotSource = "job"
+	SnapshotSourceSchedule SnapshotSource = "schedule"
+)
+
+// Snapshot - сохранённый результат парсинга категории магазина по адресу. Provider - агрегатор,
+// через который получена выдача. В списках снимков Products не заполняется, заполняется ProductsCount.
+type Snapshot struct {
+	ID            string
+	Provider      string
+	Market        string
+	Address       string
+	Category      string
+	Source        SnapshotSource
+	CreatedAt     time.Time
+	ProductsCount int
+	Products      []Products
+}
+
+// SnapshotFilter - условия выборки снимков. Address сравнивается после NormalizeAddress,
+// пустые поля не ограничивают выборку.
+type SnapshotFilter struct {
+	Provider string
+	Market   string
+	Address  string
+	Category string
+	Limit    int
+}
+
+type snapshotSourceKey struct{}
+
+// WithSnapshotSource помечает, откуда запущен парсинг, чтобы снимок сохранился с этим источником.
+func WithSnapshotSource(ctx context.Context, source SnapshotSource) context.Context {
+	return context.WithValue(ctx, snapshotSourceKey{}, source)
+}
+
+// SnapshotSourceFromContext возвращает источник парсинга, по умолчанию SnapshotSourceHTTP.
+func SnapshotSourceFromContext(ctx context.Context) SnapshotSource {
+	if source, ok := ctx.Value(snapshotSourceKey{}).(SnapshotSource); ok {
+		return source
+	}
+	return SnapshotSourceHTTP
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "pending"
+	DeliveryStatusDelivered DeliveryStatus = "delivered"
+	DeliveryStatusFailed    DeliveryStatus = "failed"
+)
+
+// WebhookAttempt - одна попытка отправки результата на callback_url.
+// StatusCode равен 0, если ответ не получен.
+type WebhookAttempt struct {
+	Attempt    int
+	At         time.Time
+	StatusCode int
+	Err        string
+	Duration   time.Duration
+}
+
+// WebhookDelivery - запись журнала доставки результата задачи на callback_url.
+type WebhookDelivery struct {
+	ID          string
+	JobID       string
+	URL         string
+	Status      DeliveryStatus
+	Attempts    []WebhookAttempt
+	CreatedAt   time.Time
+	DeliveredAt time.Time
+}
+
+// DeliveryFilter - условия выборки журнала доставок. Пустые поля не ограничивают выборку.
+type DeliveryFilter struct {
+	JobID  string
+	Status DeliveryStatus
+	Limit  int
+}
+
+// ValidateCallbackURL проверяет callback_url задачи: схема http(s), хост не localhost и не адрес
+// из loopback или частных сетей. Хосты из allowedHosts не проверяются. Имя хоста, которое резолвится
+// во внутренний адрес, отклоняет отправитель при подключении.
+func ValidateCallbackURL(raw string, allowedHosts []string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: %s", ErrInvalidCallbackURL, raw)
+	}
+
+	host := u.Hostname()
+	if slices.Contains(allowedHosts, host) {
+		return nil
+	}
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return fmt.Errorf("%w: %s is not a public host", ErrInvalidCallbackURL, host)
+	}
+	if ip := net.ParseIP(host); ip != nil && !PublicIP(ip) {
+		return fmt.Errorf("%w: %s is not a public address", ErrInvalidCallbackURL, host)
+	}
+
+	return nil
+}
+
+// PublicIP - адрес не из loopback, частных, link-local и multicast сетей и не unspecified.
+func PublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast() && !ip.IsUnspecified()
+}
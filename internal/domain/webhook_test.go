@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateCallbackURL(t *testing.T) {
+	tests := []struct {
+		url          string
+		allowedHosts []string
+		wantErr      bool
+	}{
+		{url: "https://example.com/hook"},
+		{url: "http://93.184.216.34:8080/hook"},
+		{url: "ftp://example.com/hook", wantErr: true},
+		{url: "https:///hook", wantErr: true},
+		{url: "http://localhost:8080/hook", wantErr: true},
+		{url: "http://api.localhost/hook", wantErr: true},
+		{url: "http://127.0.0.1/hook", wantErr: true},
+		{url: "http://[::1]/hook", wantErr: true},
+		{url: "http://10.0.0.5/hook", wantErr: true},
+		{url: "http://192.168.1.10/hook", wantErr: true},
+		{url: "http://169.254.169.254/latest/meta-data", wantErr: true},
+		{url: "http://0.0.0.0/hook", wantErr: true},
+		{url: "http://localhost:8080/hook", allowedHosts: []string{"localhost"}},
+		{url: "http://10.0.0.5/hook", allowedHosts: []string{"10.0.0.5"}},
+	}
+
+	for _, tt := range tests {
+		err := ValidateCallbackURL(tt.url, tt.allowedHosts)
+		if tt.wantErr != (err != nil) {
+			t.Errorf("ValidateCallbackURL(%q, %v) = %v, want error %v", tt.url, tt.allowedHosts, err, tt.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidCallbackURL) {
+			t.Errorf("ValidateCallbackURL(%q) = %v, want %v", tt.url, err, ErrInvalidCallbackURL)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+// utf8BOM нужен Excel, чтобы открыть файл в UTF-8, а не в системной кодировке, и не испортить кириллицу.
+const utf8BOM = "\xEF\xBB\xBF"
+
+func WriteCSV(w io.Writer, products []domain.Products) error {
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return fmt.Errorf("write bom: %w", err)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(columns); err != nil {
+		return fmt.Errorf("write header: %w", err)
+	}
+
+	record := make([]string, len(columns))
+	for _, p := range products {
+		for i, v := range row(p) {
+			record[i] = formatValue(v)
+		}
+		if err := cw.Write(record); err != nil {
+			return fmt.Errorf("write record: %w", err)
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("flush: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+// Package export записывает товары в файлы JSON, CSV, XLSX и Parquet для Excel и загрузки в хранилища данных.
+package export
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+type Format string
+
+const (
+	FormatJSON    Format = "json"
+	FormatCSV     Format = "csv"
+	FormatXLSX    Format = "xlsx"
+	FormatParquet Format = "parquet"
+)
+
+// FormatFromPath определяет формат по расширению файла, для неизвестного расширения - FormatJSON.
+func FormatFromPath(path string) Format {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return FormatCSV
+	case ".xlsx":
+		return FormatXLSX
+	case ".parquet":
+		return FormatParquet
+	default:
+		return FormatJSON
+	}
+}
+
+// ParseFormat проверяет формат, пустая строка означает FormatJSON.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case "":
+		return FormatJSON, nil
+	case FormatJSON, FormatCSV, FormatXLSX, FormatParquet:
+		return f, nil
+	default:
+		return "", fmt.Errorf("%w: %q", domain.ErrUnknownExportFormat, s)
+	}
+}
+
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case FormatParquet:
+		return "application/vnd.apache.parquet"
+	default:
+		return "application/json"
+	}
+}
+
+// Write записывает товары в формате format. Поля JSON совпадают с ответом /parse.
+func Write(w io.Writer, format Format, products []domain.Products) error {
+	switch format {
+	case FormatJSON:
+		return WriteJSON(w, products)
+	case FormatCSV:
+		return WriteCSV(w, products)
+	case FormatXLSX:
+		return WriteXLSX(w, products)
+	case FormatParquet:
+		return WriteParquet(w, products)
+	default:
+		return fmt.Errorf("%w: %q", domain.ErrUnknownExportFormat, format)
+	}
+}
+
+// columns - колонки CSV и XLSX, по порядку значений из row.
+var columns = []string{
+	"name",
+	"price",
+	"original_price",
+	"discount_percent",
+	"link",
+	"id",
+	"sku",
+	"brand",
+	"pack_size",
+	"pack_unit",
+	"in_stock",
+	"max_quantity",
+	"rating",
+	"image_urls",
+}
+
+// row возвращает значения товара для columns. Отсутствующие поля - nil.
+func row(p domain.Products) []any {
+	return []any{
+		p.Name,
+		p.Price,
+		deref(p.OriginalPrice),
+		deref(p.DiscountPercent),
+		p.URL,
+		deref(p.ID),
+		deref(p.SKU),
+		deref(p.Brand),
+		deref(p.PackSize),
+		deref(p.PackUnit),
+		deref(p.InStock),
+		deref(p.MaxQuantity),
+		deref(p.Rating),
+		strings.Join(p.ImageURLs, " "),
+	}
+}
+
+func deref[T any](v *T) any {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
+
+// formatValue переводит значение row в строку CSV. Дробные числа пишутся с точкой без экспоненты.
+// Строка, которую Excel принял бы за формулу, экранируется апострофом: название товара приходит с сайта.
+func formatValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+			return "'" + v
+		}
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(v)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
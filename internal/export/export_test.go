@@ -0,0 +1,106 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+func TestFormatValue(t *testing.T) {
+	tests := []struct {
+		v    any
+		want string
+	}{
+		{v: nil, want: ""},
+		{v: "Молоко 3,2%", want: "Молоко 3,2%"},
+		{v: "", want: ""},
+		{v: 199.9, want: "199.9"},
+		{v: 1e7, want: "10000000"},
+		{v: -5.5, want: "-5.5"},
+		{v: 12, want: "12"},
+		{v: true, want: "true"},
+		{v: "=HYPERLINK(\"https://evil.test\")", want: "'=HYPERLINK(\"https://evil.test\")"},
+		{v: "+7 (495) 000-00-00", want: "'+7 (495) 000-00-00"},
+		{v: "-1+1", want: "'-1+1"},
+		{v: "@SUM(A1)", want: "'@SUM(A1)"},
+		{v: "\t=1", want: "'\t=1"},
+		{v: "a=1", want: "a=1"},
+	}
+
+	for _, tt := range tests {
+		if got := formatValue(tt.v); got != tt.want {
+			t.Errorf("formatValue(%#v) = %q, want %q", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	sku := "12345"
+	products := []domain.Products{
+		{Name: "=1+1", Price: 99.5, URL: "https://kuper.ru/metro/milk", SKU: &sku, ImageURLs: []string{"a.jpg", "b.jpg"}},
+	}
+
+	buf := &bytes.Buffer{}
+	if err := WriteCSV(buf, products); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+	if !strings.HasPrefix(buf.String(), utf8BOM) {
+		t.Fatalf("csv does not start with utf-8 bom")
+	}
+
+	records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(buf.String(), utf8BOM))).ReadAll()
+	if err != nil {
+		t.Fatalf("read csv: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want header and 1 row", len(records))
+	}
+	if !slices.Equal(records[0], columns) {
+		t.Errorf("header = %v, want %v", records[0], columns)
+	}
+
+	got := map[string]string{}
+	for i, c := range records[0] {
+		got[c] = records[1][i]
+	}
+	want := map[string]string{"name": "'=1+1", "price": "99.5", "original_price": "", "link": "https://kuper.ru/metro/milk", "sku": "12345", "image_urls": "a.jpg b.jpg"}
+	for c, v := range want {
+		if got[c] != v {
+			t.Errorf("column %s = %q, want %q", c, got[c], v)
+		}
+	}
+}
+
+func TestWriteXLSXStringCells(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if err := WriteXLSX(buf, []domain.Products{{Name: "=1+1", Price: 10}}); err != nil {
+		t.Fatalf("write xlsx: %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("open xlsx: %v", err)
+	}
+	f, err := zr.Open("xl/worksheets/sheet1.xml")
+	if err != nil {
+		t.Fatalf("open sheet: %v", err)
+	}
+	defer f.Close()
+	sheet, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("read sheet: %v", err)
+	}
+
+	if bytes.Contains(sheet, []byte("<f>")) {
+		t.Errorf("sheet contains a formula cell: %s", sheet)
+	}
+	if !bytes.Contains(sheet, []byte(`<c r="A2" t="inlineStr"><is><t>=1+1</t></is></c>`)) {
+		t.Errorf("name is not written as a string cell: %s", sheet)
+	}
+}
@@ -0,0 +1,57 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+// jsonProduct повторяет товар из ответа /parse, чтобы выгрузку можно было сравнить командой diff.
+type jsonProduct struct {
+	Name            string   `json:"name"`
+	Price           float64  `json:"price"`
+	Link            string   `json:"link"`
+	ID              *string  `json:"id,omitempty"`
+	SKU             *string  `json:"sku,omitempty"`
+	Brand           *string  `json:"brand,omitempty"`
+	PackSize        *float64 `json:"pack_size,omitempty"`
+	PackUnit        *string  `json:"pack_unit,omitempty"`
+	OriginalPrice   *float64 `json:"original_price,omitempty"`
+	DiscountPercent *float64 `json:"discount_percent,omitempty"`
+	InStock         *bool    `json:"in_stock,omitempty"`
+	MaxQuantity     *int     `json:"max_quantity,omitempty"`
+	ImageURLs       []string `json:"image_urls,omitempty"`
+	Rating          *float64 `json:"rating,omitempty"`
+}
+
+func WriteJSON(w io.Writer, products []domain.Products) error {
+	res := make([]jsonProduct, 0, len(products))
+	for _, p := range products {
+		res = append(res, jsonProduct{
+			Name:            p.Name,
+			Price:           p.Price,
+			Link:            p.URL,
+			ID:              p.ID,
+			SKU:             p.SKU,
+			Brand:           p.Brand,
+			PackSize:        p.PackSize,
+			PackUnit:        p.PackUnit,
+			OriginalPrice:   p.OriginalPrice,
+			DiscountPercent: p.DiscountPercent,
+			InStock:         p.InStock,
+			MaxQuantity:     p.MaxQuantity,
+			ImageURLs:       p.ImageURLs,
+			Rating:          p.Rating,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(res); err != nil {
+		return fmt.Errorf("encode products: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package export
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+// parquetProduct - строка parquet. Указатели становятся optional-колонками.
+type parquetProduct struct {
+	Name            string   `parquet:"name"`
+	Price           float64  `parquet:"price"`
+	OriginalPrice   *float64 `parquet:"original_price,optional"`
+	DiscountPercent *float64 `parquet:"discount_percent,optional"`
+	Link            string   `parquet:"link"`
+	ID              *string  `parquet:"id,optional"`
+	SKU             *string  `parquet:"sku,optional"`
+	Brand           *string  `parquet:"brand,optional"`
+	PackSize        *float64 `parquet:"pack_size,optional"`
+	PackUnit        *string  `parquet:"pack_unit,optional"`
+	InStock         *bool    `parquet:"in_stock,optional"`
+	MaxQuantity     *int64   `parquet:"max_quantity,optional"`
+	Rating          *float64 `parquet:"rating,optional"`
+	ImageURLs       []string `parquet:"image_urls,list"`
+}
+
+func WriteParquet(w io.Writer, products []domain.Products) error {
+	rows := make([]parquetProduct, 0, len(products))
+	for _, p := range products {
+		r := parquetProduct{
+			Name:            p.Name,
+			Price:           p.Price,
+			OriginalPrice:   p.OriginalPrice,
+			DiscountPercent: p.DiscountPercent,
+			Link:            p.URL,
+			ID:              p.ID,
+			SKU:             p.SKU,
+			Brand:           p.Brand,
+			PackSize:        p.PackSize,
+			PackUnit:        p.PackUnit,
+			InStock:         p.InStock,
+			Rating:          p.Rating,
+			ImageURLs:       p.ImageURLs,
+		}
+		if p.MaxQuantity != nil {
+			v := int64(*p.MaxQuantity)
+			r.MaxQuantity = &v
+		}
+		rows = append(rows, r)
+	}
+
+	pw := parquet.NewGenericWriter[parquetProduct](w)
+	if _, err := pw.Write(rows); err != nil {
+		return fmt.Errorf("write rows: %w", err)
+	}
+	if err := pw.Close(); err != nil {
+		return fmt.Errorf("close writer: %w", err)
+	}
+
+	return nil
+}
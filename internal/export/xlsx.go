@@ -0,0 +1,55 @@
+package export
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+const xlsxSheet = "Products"
+
+func WriteXLSX(w io.Writer, products []domain.Products) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetName("Sheet1", xlsxSheet); err != nil {
+		return fmt.Errorf("rename sheet: %w", err)
+	}
+
+	// потоковая запись не держит в памяти ячейки всех строк
+	sw, err := f.NewStreamWriter(xlsxSheet)
+	if err != nil {
+		return fmt.Errorf("new stream writer: %w", err)
+	}
+
+	header := make([]any, len(columns))
+	for i, c := range columns {
+		header[i] = c
+	}
+	if err := sw.SetRow("A1", header); err != nil {
+		return fmt.Errorf("write header: %w", err)
+	}
+
+	for i, p := range products {
+		cell, err := excelize.CoordinatesToCellName(1, i+2)
+		if err != nil {
+			return fmt.Errorf("cell name: %w", err)
+		}
+		// строки пишутся ячейками inlineStr, поэтому значение вида "=..." Excel не выполняет как формулу
+		if err := sw.SetRow(cell, row(p)); err != nil {
+			return fmt.Errorf("write row: %w", err)
+		}
+	}
+
+	if err := sw.Flush(); err != nil {
+		return fmt.Errorf("flush: %w", err)
+	}
+	if _, err := f.WriteTo(w); err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+type AlertRepository interface {
+	SaveRule(ctx context.Context, rule *domain.AlertRule) error
+	GetRule(ctx context.Context, id string) (*domain.AlertRule, error)
+	// ListRules возвращает правила в порядке создания.
+	ListRules(ctx context.Context) ([]domain.AlertRule, error)
+	// DeleteRule удаляет правило вместе с его отправленными оповещениями.
+	DeleteRule(ctx context.Context, id string) error
+
+	// FiredAlerts возвращает Fingerprint последнего отправленного оповещения правила по Key события.
+	FiredAlerts(ctx context.Context, ruleID string) (map[string]string, error)
+	// SaveFiredAlert запоминает отправленное оповещение, пустой fingerprint снимает отметку.
+	SaveFiredAlert(ctx context.Context, ruleID string, key string, fingerprint string) error
+}
+
+// AlertNotifier отправляет оповещения сработавшего правила одним сообщением.
+type AlertNotifier interface {
+	Notify(ctx context.Context, rule *domain.AlertRule, events []domain.AlertEvent) error
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+type CatalogRepository interface {
+	GetCategories(ctx context.Context, address string, market string) ([]domain.Category, error)
+	GetMarkets(ctx context.Context, address string) ([]domain.Market, error)
+}
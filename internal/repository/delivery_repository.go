@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+type DeliveryRepository interface {
+	SaveDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error
+	GetDelivery(ctx context.Context, id string) (*domain.WebhookDelivery, error)
+	ListDeliveries(ctx context.Context, filter domain.DeliveryFilter) ([]domain.WebhookDelivery, error)
+}
+
+// WebhookSender отправляет результат задачи на url. Возвращает код ответа, 0 - если ответ не получен.
+type WebhookSender interface {
+	Send(ctx context.Context, deliveryID string, url string, job *domain.Job) (int, error)
+}
@@ -4,7 +4,8 @@ import "context"
 
 type Element interface {
 	Element(ctx context.Context, selector string) (Element, error)
-	
+	Has(ctx context.Context, selector string) (bool, Element, error)
+
 	Click(ctx context.Context) error
 	Input(ctx context.Context, text string) error
 	ScrollIntoView(ctx context.Context) error
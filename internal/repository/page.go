@@ -17,22 +17,33 @@ type Page interface {
 	InputAddress(ctx context.Context, address string, addressInputSelector string) error
 	ClickDropDownAddress(ctx context.Context, addressInputDropDownSelector string) error
 	SaveDeliveryAddress(ctx context.Context, addressSaveButtonSelector string) error
-	ParsePages(ctx context.Context, lastPageNum int) ([]domain.Products, error)
+	// ParsePages разбирает страницы списка с firstPageNum по lastPageNum включительно
+	ParsePages(ctx context.Context, firstPageNum int, lastPageNum int) ([]domain.Products, error)
+	CaptureRequest(ctx context.Context, urlPattern string, targetURL string) (*domain.CapturedRequest, error)
+	CaptureResponse(ctx context.Context, urlPattern string, targetURL string) (string, error)
 
 	// low-level methods
 	// navigation
 	Navigate(ctx context.Context, targetURL string) error
 	NavigateWithReferrer(ctx context.Context, marketURL string) error
+	NewTab(ctx context.Context) (Page, error)
 
 	// operations with page elements
 	Element(ctx context.Context, selector string) (Element, error)
+	Elements(ctx context.Context, selector string) ([]Element, error)
 	Has(ctx context.Context, selector string) (bool, Element, error)
 	HTML(ctx context.Context) (string, error)
 	EachEvent(ctx context.Context) (<-chan domain.Products, <-chan error, func())
 	GetPageURL(ctx context.Context) (string, error)
 	MoveCursorToElement(ctx context.Context, selector string) error
 	KeyboardType(ctx context.Context, key ...input.Key) error
+	Screenshot(ctx context.Context) ([]byte, error)
 
+	// session storage
+	Cookies(ctx context.Context) ([]domain.Cookie, error)
+	SetCookies(ctx context.Context, cookies []domain.Cookie) error
+	LocalStorage(ctx context.Context) (map[string]string, error)
+	SetLocalStorage(ctx context.Context, items map[string]string) error
 
 	// wait opertaions
 	WaitStable(ctx context.Context) error
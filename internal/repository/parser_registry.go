@@ -0,0 +1,13 @@
+package repository
+
+import "github.com/vo1dFl0w/market-parser/internal/domain"
+
+// ParserRegistry выбирает реализацию парсера по имени агрегатора (provider).
+// Пустой provider означает агрегатор по умолчанию, пустой mode - режим по умолчанию агрегатора.
+type ParserRegistry interface {
+	Parser(provider string, mode domain.ParseMode) (ParserRepository, error)
+	Catalog(provider string) (CatalogRepository, error)
+	Checker(provider string) (SelectorChecker, error)
+	DefaultProvider() string
+	Providers() []string
+}
@@ -8,4 +8,6 @@ import (
 
 type ParserRepository interface {
 	GetAllProductsByCategory(ctx context.Context, category string, address string, market string) ([]domain.Products, error)
+	GetAllProductsByCategoryForMarkets(ctx context.Context, category string, address string, markets []string) ([]domain.MarketProducts, error)
+	SearchProducts(ctx context.Context, filter domain.SearchFilter, address string, market string) ([]domain.Products, error)
 }
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+type ScheduleRunRepository interface {
+	SaveRun(ctx context.Context, run *domain.ScheduleRun) error
+	// ListRuns возвращает последние запуски расписания, новые первыми.
+	ListRuns(ctx context.Context, schedule string, limit int) ([]domain.ScheduleRun, error)
+}
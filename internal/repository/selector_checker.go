@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+// SelectorChecker проходит сценарий агрегатора и проверяет каждый селектор из его конфигурации.
+// Пустая category пропускает проверки страниц категории.
+type SelectorChecker interface {
+	CheckSelectors(ctx context.Context, address string, market string, category string) ([]domain.SelectorCheck, error)
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+type SessionRepository interface {
+	GetSession(ctx context.Context, market string, address string) (*domain.AddressSession, error)
+	SaveSession(ctx context.Context, session *domain.AddressSession) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+type SnapshotRepository interface {
+	SaveSnapshot(ctx context.Context, snapshot *domain.Snapshot) error
+	GetSnapshot(ctx context.Context, id string) (*domain.Snapshot, error)
+	// ListSnapshots возвращает снимки без товаров, новые первыми.
+	ListSnapshots(ctx context.Context, filter domain.SnapshotFilter) ([]domain.Snapshot, error)
+	// ListPricePoints возвращает цены товара по каноничной ссылке или артикулу, старые первыми.
+	ListPricePoints(ctx context.Context, filter domain.PriceHistoryFilter) ([]domain.PricePoint, error)
+}
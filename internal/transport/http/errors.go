@@ -10,18 +10,25 @@ import (
 
 const (
 	StatusClientClosedRequest = 499
+	// captchaRetryAfter - через сколько секунд повторять запрос, если маркетплейс не пропускает через капчу
+	captchaRetryAfter = 300
 )
 
 var (
 	ErrBadRequest          = errors.New("bad request")
+	ErrCaptchaBlocked      = errors.New("blocked by captcha")
 	ErrClientClosedRequest = errors.New("client closed request")
 	ErrGatewayTimeout      = errors.New("gateway timeout")
 	ErrInternalServerError = errors.New("internal server error")
+	ErrNotFound            = errors.New("not found")
+	ErrServiceUnavailable  = errors.New("service unavailable")
 )
 
 type HTTPError struct {
 	Message string
 	Status  int
+	// RetryAfter - заголовок Retry-After в секундах, 0 - без заголовка
+	RetryAfter int
 }
 
 func (e *HTTPError) Error() string {
@@ -36,11 +43,240 @@ func (e *HTTPError) ToParseErrRes() httpgen.APIV1MarketParserParseGetRes {
 		return &httpgen.APIV1MarketParserParseGetCode499{Message: e.Message, Status: e.Status}
 	case http.StatusGatewayTimeout:
 		return &httpgen.APIV1MarketParserParseGetGatewayTimeout{Message: e.Message, Status: e.Status}
+	case http.StatusServiceUnavailable:
+		return e.toRetryAfterRes()
 	default:
 		return &httpgen.APIV1MarketParserParseGetInternalServerError{Message: e.Message, Status: e.Status}
 	}
 }
 
+func (e *HTTPError) ToParseMarketsErrRes() httpgen.APIV1MarketParserParseMarketsGetRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1MarketParserParseMarketsGetBadRequest{Message: e.Message, Status: e.Status}
+	case StatusClientClosedRequest:
+		return &httpgen.APIV1MarketParserParseMarketsGetCode499{Message: e.Message, Status: e.Status}
+	case http.StatusGatewayTimeout:
+		return &httpgen.APIV1MarketParserParseMarketsGetGatewayTimeout{Message: e.Message, Status: e.Status}
+	case http.StatusServiceUnavailable:
+		return e.toRetryAfterRes()
+	default:
+		return &httpgen.APIV1MarketParserParseMarketsGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToCategoriesErrRes() httpgen.APIV1MarketParserCategoriesGetRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1MarketParserCategoriesGetBadRequest{Message: e.Message, Status: e.Status}
+	case StatusClientClosedRequest:
+		return &httpgen.APIV1MarketParserCategoriesGetCode499{Message: e.Message, Status: e.Status}
+	case http.StatusGatewayTimeout:
+		return &httpgen.APIV1MarketParserCategoriesGetGatewayTimeout{Message: e.Message, Status: e.Status}
+	case http.StatusServiceUnavailable:
+		return e.toRetryAfterRes()
+	default:
+		return &httpgen.APIV1MarketParserCategoriesGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToMarketsErrRes() httpgen.APIV1MarketParserMarketsGetRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1MarketParserMarketsGetBadRequest{Message: e.Message, Status: e.Status}
+	case StatusClientClosedRequest:
+		return &httpgen.APIV1MarketParserMarketsGetCode499{Message: e.Message, Status: e.Status}
+	case http.StatusGatewayTimeout:
+		return &httpgen.APIV1MarketParserMarketsGetGatewayTimeout{Message: e.Message, Status: e.Status}
+	case http.StatusServiceUnavailable:
+		return e.toRetryAfterRes()
+	default:
+		return &httpgen.APIV1MarketParserMarketsGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToSearchErrRes() httpgen.APIV1MarketParserSearchGetRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1MarketParserSearchGetBadRequest{Message: e.Message, Status: e.Status}
+	case StatusClientClosedRequest:
+		return &httpgen.APIV1MarketParserSearchGetCode499{Message: e.Message, Status: e.Status}
+	case http.StatusGatewayTimeout:
+		return &httpgen.APIV1MarketParserSearchGetGatewayTimeout{Message: e.Message, Status: e.Status}
+	case http.StatusServiceUnavailable:
+		return e.toRetryAfterRes()
+	default:
+		return &httpgen.APIV1MarketParserSearchGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToCreateJobErrRes() httpgen.APIV1JobsPostRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1JobsPostBadRequest{Message: e.Message, Status: e.Status}
+	case http.StatusServiceUnavailable:
+		return &httpgen.APIV1JobsPostServiceUnavailable{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1JobsPostInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToGetJobErrRes() httpgen.APIV1JobsIDGetRes {
+	switch e.Status {
+	case http.StatusNotFound:
+		return &httpgen.APIV1JobsIDGetNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1JobsIDGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToCancelJobErrRes() httpgen.APIV1JobsIDDeleteRes {
+	switch e.Status {
+	case http.StatusNotFound:
+		return &httpgen.APIV1JobsIDDeleteNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1JobsIDDeleteInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToDeliveriesErrRes() httpgen.APIV1WebhooksDeliveriesGetRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1WebhooksDeliveriesGetBadRequest{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1WebhooksDeliveriesGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToDeliveryErrRes() httpgen.APIV1WebhooksDeliveriesIDGetRes {
+	switch e.Status {
+	case http.StatusNotFound:
+		return &httpgen.APIV1WebhooksDeliveriesIDGetNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1WebhooksDeliveriesIDGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToScheduleRunsErrRes() httpgen.APIV1SchedulesNameRunsGetRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1SchedulesNameRunsGetBadRequest{Message: e.Message, Status: e.Status}
+	case http.StatusNotFound:
+		return &httpgen.APIV1SchedulesNameRunsGetNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1SchedulesNameRunsGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToSnapshotsErrRes() httpgen.APIV1SnapshotsGetRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1SnapshotsGetBadRequest{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1SnapshotsGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToSnapshotErrRes() httpgen.APIV1SnapshotsIDGetRes {
+	switch e.Status {
+	case http.StatusNotFound:
+		return &httpgen.APIV1SnapshotsIDGetNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1SnapshotsIDGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToPriceHistoryErrRes() httpgen.APIV1ProductsIDHistoryGetRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1ProductsIDHistoryGetBadRequest{Message: e.Message, Status: e.Status}
+	case http.StatusNotFound:
+		return &httpgen.APIV1ProductsIDHistoryGetNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1ProductsIDHistoryGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToDiffErrRes() httpgen.APIV1DiffGetRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1DiffGetBadRequest{Message: e.Message, Status: e.Status}
+	case http.StatusNotFound:
+		return &httpgen.APIV1DiffGetNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1DiffGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToCreateAlertErrRes() httpgen.APIV1AlertsPostRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1AlertsPostBadRequest{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1AlertsPostInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToGetAlertErrRes() httpgen.APIV1AlertsIDGetRes {
+	switch e.Status {
+	case http.StatusNotFound:
+		return &httpgen.APIV1AlertsIDGetNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1AlertsIDGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToUpdateAlertErrRes() httpgen.APIV1AlertsIDPutRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1AlertsIDPutBadRequest{Message: e.Message, Status: e.Status}
+	case http.StatusNotFound:
+		return &httpgen.APIV1AlertsIDPutNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1AlertsIDPutInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToDeleteAlertErrRes() httpgen.APIV1AlertsIDDeleteRes {
+	switch e.Status {
+	case http.StatusNotFound:
+		return &httpgen.APIV1AlertsIDDeleteNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1AlertsIDDeleteInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToSelectorsErrRes() httpgen.APIV1DiagnosticsSelectorsGetRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1DiagnosticsSelectorsGetBadRequest{Message: e.Message, Status: e.Status}
+	case StatusClientClosedRequest:
+		return &httpgen.APIV1DiagnosticsSelectorsGetCode499{Message: e.Message, Status: e.Status}
+	case http.StatusGatewayTimeout:
+		return &httpgen.APIV1DiagnosticsSelectorsGetGatewayTimeout{Message: e.Message, Status: e.Status}
+	case http.StatusServiceUnavailable:
+		return e.toRetryAfterRes()
+	default:
+		return &httpgen.APIV1DiagnosticsSelectorsGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+// toRetryAfterRes - ответ 503 с Retry-After, общий для эндпоинтов парсинга.
+func (e *HTTPError) toRetryAfterRes() *httpgen.ErrorResponseHeaders {
+	res := &httpgen.ErrorResponseHeaders{Response: httpgen.ErrorResponse{Message: e.Message, Status: e.Status}}
+	if e.RetryAfter > 0 {
+		res.RetryAfter = httpgen.NewOptInt(e.RetryAfter)
+	}
+
+	return res
+}
+
+// ErrorMessage - сообщение об ошибке, которое видят клиенты api, без внутренних подробностей.
+// Им же передаются ошибки внешним получателям: в webhook и в истории запусков расписаний.
+func ErrorMessage(err error) string {
+	return MapError(err).Message
+}
+
 func MapError(err error) *HTTPError {
 	switch {
 	case errors.Is(err, domain.ErrEmptyCategory):
@@ -49,6 +285,48 @@ func MapError(err error) *HTTPError {
 		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
 	case errors.Is(err, domain.ErrEmptyMarket):
 		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrUnknownParseMode):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrUnknownProvider):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrNotSupported):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrEmptyQuery):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrUnknownSearchSort):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrInvalidPriceRange):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrJobNotFound):
+		return &HTTPError{Message: ErrNotFound.Error(), Status: http.StatusNotFound}
+	case errors.Is(err, domain.ErrInvalidCallbackURL):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrDeliveryNotFound):
+		return &HTTPError{Message: ErrNotFound.Error(), Status: http.StatusNotFound}
+	case errors.Is(err, domain.ErrScheduleNotFound):
+		return &HTTPError{Message: ErrNotFound.Error(), Status: http.StatusNotFound}
+	case errors.Is(err, domain.ErrSnapshotNotFound):
+		return &HTTPError{Message: ErrNotFound.Error(), Status: http.StatusNotFound}
+	case errors.Is(err, domain.ErrPriceHistoryEmpty):
+		return &HTTPError{Message: ErrNotFound.Error(), Status: http.StatusNotFound}
+	case errors.Is(err, domain.ErrEmptyProductID):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrInvalidTimeRange):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrSnapshotsMismatch):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrUnknownExportFormat):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrInvalidAlertRule):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrAlertRuleNotFound):
+		return &HTTPError{Message: ErrNotFound.Error(), Status: http.StatusNotFound}
+	case errors.Is(err, domain.ErrJobQueueFull):
+		return &HTTPError{Message: ErrServiceUnavailable.Error(), Status: http.StatusServiceUnavailable}
+	case errors.Is(err, domain.ErrJobQueueClosed):
+		return &HTTPError{Message: ErrServiceUnavailable.Error(), Status: http.StatusServiceUnavailable}
+	case errors.Is(err, domain.ErrCaptchaBlocked):
+		return &HTTPError{Message: ErrCaptchaBlocked.Error(), Status: http.StatusServiceUnavailable, RetryAfter: captchaRetryAfter}
 	case errors.Is(err, domain.ErrClientClosedRequest):
 		return &HTTPError{Message: ErrClientClosedRequest.Error(), Status: StatusClientClosedRequest}
 	case errors.Is(err, domain.ErrGatewayTimeout):
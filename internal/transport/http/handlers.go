@@ -1,10 +1,17 @@
 package http
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/export"
 	"github.com/vo1dFl0w/market-parser/internal/transport/http/httpgen"
 	"github.com/vo1dFl0w/market-parser/internal/usecase"
 	"github.com/vo1dFl0w/market-parser/pkg/logger"
@@ -13,34 +20,824 @@ import (
 type Handler struct {
 	logger         logger.Logger
 	parserSrv      usecase.ParserService
+	catalogSrv     usecase.CatalogService
+	jobSrv         usecase.JobService
+	webhookSrv     usecase.WebhookService
+	schedulerSrv   usecase.SchedulerService
+	snapshotSrv    usecase.SnapshotService
+	alertSrv       usecase.AlertService
+	diagnosticsSrv usecase.DiagnosticsService
 	requestTimeout time.Duration
+	streamTimeout  time.Duration
 }
 
-func NewHandler(logger logger.Logger, parserSrv usecase.ParserService, requestTimeout time.Duration) *Handler {
-	return &Handler{logger: logger, parserSrv: parserSrv, requestTimeout: requestTimeout}
+func NewHandler(logger logger.Logger, parserSrv usecase.ParserService, catalogSrv usecase.CatalogService, jobSrv usecase.JobService, webhookSrv usecase.WebhookService, schedulerSrv usecase.SchedulerService, snapshotSrv usecase.SnapshotService, alertSrv usecase.AlertService, diagnosticsSrv usecase.DiagnosticsService, requestTimeout time.Duration, streamTimeout time.Duration) *Handler {
+	return &Handler{
+		logger:         logger,
+		parserSrv:      parserSrv,
+		catalogSrv:     catalogSrv,
+		jobSrv:         jobSrv,
+		webhookSrv:     webhookSrv,
+		schedulerSrv:   schedulerSrv,
+		snapshotSrv:    snapshotSrv,
+		alertSrv:       alertSrv,
+		diagnosticsSrv: diagnosticsSrv,
+		requestTimeout: requestTimeout,
+		streamTimeout:  streamTimeout,
+	}
 }
 
 func (h *Handler) APIV1MarketParserParseGet(ctx context.Context, params httpgen.APIV1MarketParserParseGetParams) (httpgen.APIV1MarketParserParseGetRes, error) {
-	res, err := h.parserSrv.ParseProductsByCategory(ctx, params.Category, params.Address, params.Market)
+	format, err := export.ParseFormat(string(params.Format.Or("")))
 	if err != nil {
 		httpErr := MapError(err)
 		h.LogHTTPError(ctx, err, httpErr)
 		return httpErr.ToParseErrRes(), nil
 	}
-	
 
-	resp := make(httpgen.ParseResponse, 0, len(res))
-	for _, p := range res {
-		resp = append(resp, httpgen.Product{
-			Name:  p.Name,
-			Link:  p.URL,
-			Price: p.Price,
+	res, err := h.parserSrv.ParseProductsByCategory(ctx, params.Provider.Or(""), params.Category, params.Address, params.Market, domain.ParseMode(params.Mode.Or("")))
+	failedPages, partial := h.failedPages(res, err)
+	if err != nil && !partial {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToParseErrRes(), nil
+	}
+
+	if format == export.FormatJSON {
+		resp := toParseResponse(res)
+		if partial {
+			return &httpgen.ParseResponseHeaders{XFailedPages: failedPages, Response: resp}, nil
+		}
+		return &resp, nil
+	}
+
+	buf := &bytes.Buffer{}
+	if err := export.Write(buf, format, res); err != nil {
+		err = fmt.Errorf("export %s: %w", format, err)
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToParseErrRes(), nil
+	}
+
+	switch {
+	case format == export.FormatCSV && partial:
+		return &httpgen.APIV1MarketParserParseGetPartialContentTextCsvHeaders{
+			XFailedPages: failedPages,
+			Response:     httpgen.APIV1MarketParserParseGetPartialContentTextCsv{Data: buf},
+		}, nil
+	case format == export.FormatCSV:
+		return &httpgen.APIV1MarketParserParseGetOKTextCsv{Data: buf}, nil
+	case format == export.FormatXLSX && partial:
+		return &httpgen.APIV1MarketParserParseGetPartialContentApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheetHeaders{
+			XFailedPages: failedPages,
+			Response:     httpgen.APIV1MarketParserParseGetPartialContentApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet{Data: buf},
+		}, nil
+	case format == export.FormatXLSX:
+		return &httpgen.APIV1MarketParserParseGetOKApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet{Data: buf}, nil
+	case partial:
+		return &httpgen.APIV1MarketParserParseGetPartialContentApplicationVndApacheParquetHeaders{
+			XFailedPages: failedPages,
+			Response:     httpgen.APIV1MarketParserParseGetPartialContentApplicationVndApacheParquet{Data: buf},
+		}, nil
+	default:
+		return &httpgen.APIV1MarketParserParseGetOKApplicationVndApacheParquet{Data: buf}, nil
+	}
+}
+
+func (h *Handler) APIV1MarketParserSearchGet(ctx context.Context, params httpgen.APIV1MarketParserSearchGetParams) (httpgen.APIV1MarketParserSearchGetRes, error) {
+	filter := domain.SearchFilter{
+		Query: params.Query,
+		Sort:  domain.SearchSort(params.Sort.Or("")),
+	}
+	if v, ok := params.PriceMin.Get(); ok {
+		filter.PriceMin = &v
+	}
+	if v, ok := params.PriceMax.Get(); ok {
+		filter.PriceMax = &v
+	}
+
+	res, err := h.parserSrv.SearchProducts(ctx, params.Provider.Or(""), filter, params.Address, params.Market, domain.ParseMode(params.Mode.Or("")))
+	failedPages, partial := h.failedPages(res, err)
+	if err != nil && !partial {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToSearchErrRes(), nil
+	}
+
+	resp := toParseResponse(res)
+	if partial {
+		return &httpgen.APIV1MarketParserSearchGetPartialContentHeaders{XFailedPages: failedPages, Response: resp}, nil
+	}
+
+	return &resp, nil
+}
+
+func (h *Handler) APIV1MarketParserParseMarketsGet(ctx context.Context, params httpgen.APIV1MarketParserParseMarketsGetParams) (httpgen.APIV1MarketParserParseMarketsGetRes, error) {
+	res, err := h.parserSrv.ParseProductsByCategoryForMarkets(ctx, params.Provider.Or(""), params.Category, params.Address, params.Market, domain.ParseMode(params.Mode.Or("")))
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToParseMarketsErrRes(), nil
+	}
+
+	resp := make(httpgen.MarketsParseResponse, 0, len(res))
+	for _, mp := range res {
+		result := httpgen.MarketResult{Market: mp.Market}
+		if failedPages := domain.FailedPages(mp.Err); failedPages != nil && len(mp.Products) > 0 {
+			// часть страниц не разобрана: товары остальных отдаются вместе с номерами неразобранных
+			h.logger.Warn("partial parse result", "market", mp.Market, "failed_pages", failedPages, "products", len(mp.Products), "error", mp.Err)
+			result.Products = toParseResponse(mp.Products)
+			result.FailedPages = failedPages
+		} else if mp.Err != nil {
+			// ошибка одного магазина не влияет на статус всего ответа
+			httpErr := MapError(mp.Err)
+			h.LogHTTPError(ctx, mp.Err, httpErr)
+			result.Error = httpgen.NewOptErrorResponse(httpgen.ErrorResponse{Message: httpErr.Message, Status: httpErr.Status})
+		} else {
+			result.Products = toParseResponse(mp.Products)
+		}
+		resp = append(resp, result)
+	}
+
+	return &resp, nil
+}
+
+func (h *Handler) APIV1MarketParserCategoriesGet(ctx context.Context, params httpgen.APIV1MarketParserCategoriesGetParams) (httpgen.APIV1MarketParserCategoriesGetRes, error) {
+	res, err := h.catalogSrv.GetCategories(ctx, params.Provider.Or(""), params.Market, params.Address)
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToCategoriesErrRes(), nil
+	}
+
+	resp := httpgen.CategoriesResponse(toCategories(res))
+
+	return &resp, nil
+}
+
+func (h *Handler) APIV1MarketParserMarketsGet(ctx context.Context, params httpgen.APIV1MarketParserMarketsGetParams) (httpgen.APIV1MarketParserMarketsGetRes, error) {
+	res, err := h.catalogSrv.GetMarkets(ctx, params.Provider.Or(""), params.Address)
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToMarketsErrRes(), nil
+	}
+
+	resp := make(httpgen.MarketsResponse, 0, len(res))
+	for _, m := range res {
+		market := httpgen.Market{Slug: m.Slug, Name: m.Name}
+		if m.LogoURL != "" {
+			market.LogoURL = httpgen.NewOptString(m.LogoURL)
+		}
+		if m.DeliveryInfo != "" {
+			market.DeliveryInfo = httpgen.NewOptString(m.DeliveryInfo)
+		}
+		resp = append(resp, market)
+	}
+
+	return &resp, nil
+}
+
+func (h *Handler) APIV1JobsPost(ctx context.Context, req *httpgen.JobRequest) (httpgen.APIV1JobsPostRes, error) {
+	params := domain.JobParams{
+		Provider:    req.Provider.Or(""),
+		Category:    req.Category,
+		Address:     req.Address,
+		Market:      req.Market,
+		Mode:        domain.ParseMode(req.Mode.Or("")),
+		CallbackURL: req.CallbackURL.Or(""),
+	}
+
+	res, err := h.jobSrv.Submit(ctx, params)
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToCreateJobErrRes(), nil
+	}
+
+	resp := toJob(res)
+
+	return &resp, nil
+}
+
+func (h *Handler) APIV1JobsIDGet(ctx context.Context, params httpgen.APIV1JobsIDGetParams) (httpgen.APIV1JobsIDGetRes, error) {
+	res, err := h.jobSrv.Get(ctx, params.ID)
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToGetJobErrRes(), nil
+	}
+
+	resp := toJob(res)
+
+	return &resp, nil
+}
+
+func (h *Handler) APIV1JobsIDDelete(ctx context.Context, params httpgen.APIV1JobsIDDeleteParams) (httpgen.APIV1JobsIDDeleteRes, error) {
+	res, err := h.jobSrv.Cancel(ctx, params.ID)
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToCancelJobErrRes(), nil
+	}
+
+	resp := toJob(res)
+
+	return &resp, nil
+}
+
+func toJob(j *domain.Job) httpgen.Job {
+	res := httpgen.Job{
+		ID:     j.ID,
+		Status: httpgen.JobStatus(j.Status),
+		Request: httpgen.JobRequest{
+			Category: j.Params.Category,
+			Address:  j.Params.Address,
+			Market:   j.Params.Market,
+		},
+		Progress: httpgen.JobProgress{
+			PagesDone:  j.PagesDone,
+			PagesTotal: j.PagesTotal,
+		},
+		CreatedAt: j.CreatedAt,
+	}
+	if j.Params.Provider != "" {
+		res.Request.Provider = httpgen.NewOptString(j.Params.Provider)
+	}
+	if j.Params.Mode != "" {
+		res.Request.Mode = httpgen.NewOptJobRequestMode(httpgen.JobRequestMode(j.Params.Mode))
+	}
+	if j.Params.CallbackURL != "" {
+		res.Request.CallbackURL = httpgen.NewOptString(j.Params.CallbackURL)
+	}
+	if j.Status == domain.JobStatusDone {
+		res.Products = toParseResponse(j.Products)
+		res.FailedPages = j.FailedPages
+	}
+	if j.Err != nil {
+		httpErr := MapError(j.Err)
+		res.Error = httpgen.NewOptErrorResponse(httpgen.ErrorResponse{Message: httpErr.Message, Status: httpErr.Status})
+	}
+	if !j.StartedAt.IsZero() {
+		res.StartedAt = httpgen.NewOptDateTime(j.StartedAt)
+	}
+	if !j.FinishedAt.IsZero() {
+		res.FinishedAt = httpgen.NewOptDateTime(j.FinishedAt)
+	}
+
+	return res
+}
+
+func (h *Handler) APIV1WebhooksDeliveriesGet(ctx context.Context, params httpgen.APIV1WebhooksDeliveriesGetParams) (httpgen.APIV1WebhooksDeliveriesGetRes, error) {
+	filter := domain.DeliveryFilter{
+		JobID:  params.JobID.Or(""),
+		Status: domain.DeliveryStatus(params.Status.Or("")),
+		Limit:  params.Limit.Or(0),
+	}
+
+	res, err := h.webhookSrv.ListDeliveries(ctx, filter)
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToDeliveriesErrRes(), nil
+	}
+
+	resp := make(httpgen.WebhookDeliveriesResponse, 0, len(res))
+	for _, d := range res {
+		resp = append(resp, toWebhookDelivery(&d))
+	}
+
+	return &resp, nil
+}
+
+func (h *Handler) APIV1WebhooksDeliveriesIDGet(ctx context.Context, params httpgen.APIV1WebhooksDeliveriesIDGetParams) (httpgen.APIV1WebhooksDeliveriesIDGetRes, error) {
+	res, err := h.webhookSrv.GetDelivery(ctx, params.ID)
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToDeliveryErrRes(), nil
+	}
+
+	resp := toWebhookDelivery(res)
+
+	return &resp, nil
+}
+
+func toWebhookDelivery(d *domain.WebhookDelivery) httpgen.WebhookDelivery {
+	res := httpgen.WebhookDelivery{
+		ID:        d.ID,
+		JobID:     d.JobID,
+		URL:       d.URL,
+		Status:    httpgen.WebhookDeliveryStatus(d.Status),
+		Attempts:  make([]httpgen.WebhookAttempt, 0, len(d.Attempts)),
+		CreatedAt: d.CreatedAt,
+	}
+	for _, a := range d.Attempts {
+		attempt := httpgen.WebhookAttempt{
+			Attempt:    a.Attempt,
+			At:         a.At,
+			DurationMs: a.Duration.Milliseconds(),
+		}
+		if a.StatusCode != 0 {
+			attempt.StatusCode = httpgen.NewOptInt(a.StatusCode)
+		}
+		if a.Err != "" {
+			attempt.Error = httpgen.NewOptString(a.Err)
+		}
+		res.Attempts = append(res.Attempts, attempt)
+	}
+	if !d.DeliveredAt.IsZero() {
+		res.DeliveredAt = httpgen.NewOptDateTime(d.DeliveredAt)
+	}
+
+	return res
+}
+
+func (h *Handler) APIV1SchedulesGet(ctx context.Context) (httpgen.APIV1SchedulesGetRes, error) {
+	res, err := h.schedulerSrv.Statuses(ctx)
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return &httpgen.ErrorResponse{Message: httpErr.Message, Status: httpErr.Status}, nil
+	}
+
+	resp := make(httpgen.SchedulesResponse, 0, len(res))
+	for _, st := range res {
+		status := httpgen.ScheduleStatus{
+			Name:     st.Schedule.Name,
+			Cron:     st.Schedule.Cron,
+			JitterMs: st.Schedule.Jitter.Milliseconds(),
+			Targets:  make([]httpgen.ScheduleTarget, 0, len(st.Schedule.Targets)),
+			Running:  st.Running,
+		}
+		for _, t := range st.Schedule.Targets {
+			status.Targets = append(status.Targets, toScheduleTarget(t))
+		}
+		if st.Schedule.Mode != "" {
+			status.Mode = httpgen.NewOptString(string(st.Schedule.Mode))
+		}
+		if !st.NextRun.IsZero() {
+			status.NextRun = httpgen.NewOptDateTime(st.NextRun)
+		}
+		if st.LastRun != nil {
+			status.LastRun = httpgen.NewOptScheduleRun(toScheduleRun(st.LastRun))
+		}
+		resp = append(resp, status)
+	}
+
+	return &resp, nil
+}
+
+func (h *Handler) APIV1SchedulesNameRunsGet(ctx context.Context, params httpgen.APIV1SchedulesNameRunsGetParams) (httpgen.APIV1SchedulesNameRunsGetRes, error) {
+	res, err := h.schedulerSrv.Runs(ctx, params.Name, params.Limit.Or(20))
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToScheduleRunsErrRes(), nil
+	}
+
+	resp := make(httpgen.ScheduleRunsResponse, 0, len(res))
+	for _, run := range res {
+		resp = append(resp, toScheduleRun(&run))
+	}
+
+	return &resp, nil
+}
+
+func toScheduleTarget(t domain.ScheduleTarget) httpgen.ScheduleTarget {
+	res := httpgen.ScheduleTarget{Market: t.Market, Address: t.Address, Category: t.Category}
+	if t.Provider != "" {
+		res.Provider = httpgen.NewOptString(t.Provider)
+	}
+
+	return res
+}
+
+func toScheduleRun(run *domain.ScheduleRun) httpgen.ScheduleRun {
+	res := httpgen.ScheduleRun{
+		ID:        run.ID,
+		Schedule:  run.Schedule,
+		Status:    httpgen.ScheduleRunStatus(run.Status),
+		Targets:   make([]httpgen.ScheduleTargetResult, 0, len(run.Targets)),
+		StartedAt: run.StartedAt,
+	}
+	for _, t := range run.Targets {
+		result := httpgen.ScheduleTargetResult{
+			Target:     toScheduleTarget(t.Target),
+			Products:   t.Products,
+			DurationMs: t.Duration.Milliseconds(),
+		}
+		if t.Err != "" {
+			result.Error = httpgen.NewOptString(t.Err)
+		}
+		res.Targets = append(res.Targets, result)
+	}
+	if !run.FinishedAt.IsZero() {
+		res.FinishedAt = httpgen.NewOptDateTime(run.FinishedAt)
+	}
+
+	return res
+}
+
+func (h *Handler) APIV1SnapshotsGet(ctx context.Context, params httpgen.APIV1SnapshotsGetParams) (httpgen.APIV1SnapshotsGetRes, error) {
+	filter := domain.SnapshotFilter{
+		Provider: params.Provider.Or(""),
+		Market:   params.Market.Or(""),
+		Address:  params.Address.Or(""),
+		Category: params.Category.Or(""),
+		Limit:    params.Limit.Or(0),
+	}
+
+	res, err := h.snapshotSrv.ListSnapshots(ctx, filter)
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToSnapshotsErrRes(), nil
+	}
+
+	resp := make(httpgen.SnapshotsResponse, 0, len(res))
+	for _, sn := range res {
+		resp = append(resp, toSnapshot(&sn))
+	}
+
+	return &resp, nil
+}
+
+func (h *Handler) APIV1SnapshotsIDGet(ctx context.Context, params httpgen.APIV1SnapshotsIDGetParams) (httpgen.APIV1SnapshotsIDGetRes, error) {
+	res, err := h.snapshotSrv.GetSnapshot(ctx, params.ID)
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToSnapshotErrRes(), nil
+	}
+
+	resp := toSnapshot(res)
+	// у найденного снимка список товаров присутствует всегда, даже пустой
+	resp.Products = toParseResponse(res.Products)
+
+	return &resp, nil
+}
+
+// toSnapshot не переносит товары: список снимков отдаётся без них.
+func toSnapshot(sn *domain.Snapshot) httpgen.Snapshot {
+	return httpgen.Snapshot{
+		ID:            sn.ID,
+		Provider:      sn.Provider,
+		Market:        sn.Market,
+		Address:       sn.Address,
+		Category:      sn.Category,
+		Source:        httpgen.SnapshotSource(sn.Source),
+		CreatedAt:     sn.CreatedAt,
+		ProductsCount: sn.ProductsCount,
+	}
+}
+
+func (h *Handler) APIV1ProductsIDHistoryGet(ctx context.Context, params httpgen.APIV1ProductsIDHistoryGetParams) (httpgen.APIV1ProductsIDHistoryGetRes, error) {
+	filter := domain.PriceHistoryFilter{
+		ProductID: params.ID,
+		Provider:  params.Provider.Or(""),
+		Market:    params.Market,
+		Address:   params.Address,
+		From:      params.From.Or(time.Time{}),
+		To:        params.To.Or(time.Time{}),
+	}
+
+	res, err := h.snapshotSrv.PriceHistory(ctx, filter)
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToPriceHistoryErrRes(), nil
+	}
+
+	resp := httpgen.PriceHistory{
+		ProductID: res.ProductID,
+		Name:      res.Name,
+		URL:       res.URL,
+		Provider:  res.Provider,
+		Market:    res.Market,
+		Address:   res.Address,
+		Min:       res.Min,
+		Max:       res.Max,
+		Avg:       res.Avg,
+		Points:    make([]httpgen.PricePoint, 0, len(res.Points)),
+	}
+	if res.SKU != nil {
+		resp.Sku = httpgen.NewOptString(*res.SKU)
+	}
+	for _, p := range res.Points {
+		point := httpgen.PricePoint{SnapshotID: p.SnapshotID, At: p.At, Price: p.Price}
+		if p.InStock != nil {
+			point.InStock = httpgen.NewOptBool(*p.InStock)
+		}
+		resp.Points = append(resp.Points, point)
+	}
+
+	return &resp, nil
+}
+
+func (h *Handler) APIV1DiffGet(ctx context.Context, params httpgen.APIV1DiffGetParams) (httpgen.APIV1DiffGetRes, error) {
+	res, err := h.snapshotSrv.DiffSnapshots(ctx, params.From, params.To)
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToDiffErrRes(), nil
+	}
+
+	resp := toSnapshotDiff(res)
+
+	return &resp, nil
+}
+
+func (h *Handler) APIV1DiffPost(ctx context.Context, req *httpgen.DiffRequest) (httpgen.APIV1DiffPostRes, error) {
+	res := domain.DiffProducts(fromParseResponse(req.Old), fromParseResponse(req.New))
+
+	resp := toSnapshotDiff(res)
+
+	return &resp, nil
+}
+
+func toSnapshotDiff(d *domain.SnapshotDiff) httpgen.SnapshotDiff {
+	res := httpgen.SnapshotDiff{
+		Added:        toParseResponse(d.Added),
+		Removed:      toParseResponse(d.Removed),
+		PriceUp:      toPriceChanges(d.PriceUp),
+		PriceDown:    toPriceChanges(d.PriceDown),
+		StockChanged: make([]httpgen.StockChange, 0, len(d.StockChanged)),
+	}
+	if d.From != nil {
+		res.From = httpgen.NewOptSnapshot(toSnapshot(d.From))
+	}
+	if d.To != nil {
+		res.To = httpgen.NewOptSnapshot(toSnapshot(d.To))
+	}
+	for _, c := range d.StockChanged {
+		res.StockChanged = append(res.StockChanged, httpgen.StockChange{
+			Product:    toProduct(c.Product),
+			WasInStock: c.WasInStock,
+			InStock:    c.InStock,
+		})
+	}
+
+	return res
+}
+
+func toPriceChanges(changes []domain.PriceChange) []httpgen.PriceChange {
+	res := make([]httpgen.PriceChange, 0, len(changes))
+	for _, c := range changes {
+		res = append(res, httpgen.PriceChange{
+			Product:      toProduct(c.Product),
+			OldPrice:     c.OldPrice,
+			NewPrice:     c.NewPrice,
+			Delta:        c.Delta,
+			DeltaPercent: c.DeltaPercent,
 		})
 	}
 
+	return res
+}
+
+func (h *Handler) APIV1AlertsGet(ctx context.Context) (httpgen.APIV1AlertsGetRes, error) {
+	res, err := h.alertSrv.ListRules(ctx)
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return &httpgen.ErrorResponse{Message: httpErr.Message, Status: httpErr.Status}, nil
+	}
+
+	resp := make(httpgen.AlertRulesResponse, 0, len(res))
+	for _, r := range res {
+		resp = append(resp, toAlertRule(&r))
+	}
+
+	return &resp, nil
+}
+
+func (h *Handler) APIV1AlertsPost(ctx context.Context, req *httpgen.AlertRuleRequest) (httpgen.APIV1AlertsPostRes, error) {
+	res, err := h.alertSrv.CreateRule(ctx, fromAlertRuleRequest(req))
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToCreateAlertErrRes(), nil
+	}
+
+	resp := toAlertRule(res)
+
+	return &resp, nil
+}
+
+func (h *Handler) APIV1AlertsIDGet(ctx context.Context, params httpgen.APIV1AlertsIDGetParams) (httpgen.APIV1AlertsIDGetRes, error) {
+	res, err := h.alertSrv.GetRule(ctx, params.ID)
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToGetAlertErrRes(), nil
+	}
+
+	resp := toAlertRule(res)
+
+	return &resp, nil
+}
+
+func (h *Handler) APIV1AlertsIDPut(ctx context.Context, req *httpgen.AlertRuleRequest, params httpgen.APIV1AlertsIDPutParams) (httpgen.APIV1AlertsIDPutRes, error) {
+	res, err := h.alertSrv.UpdateRule(ctx, params.ID, fromAlertRuleRequest(req))
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToUpdateAlertErrRes(), nil
+	}
+
+	resp := toAlertRule(res)
+
 	return &resp, nil
 }
 
+func (h *Handler) APIV1AlertsIDDelete(ctx context.Context, params httpgen.APIV1AlertsIDDeleteParams) (httpgen.APIV1AlertsIDDeleteRes, error) {
+	if err := h.alertSrv.DeleteRule(ctx, params.ID); err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToDeleteAlertErrRes(), nil
+	}
+
+	return &httpgen.APIV1AlertsIDDeleteNoContent{}, nil
+}
+
+func fromAlertRuleRequest(req *httpgen.AlertRuleRequest) domain.AlertRule {
+	return domain.AlertRule{
+		Name:      req.Name,
+		Kind:      domain.AlertKind(req.Kind),
+		Threshold: req.Threshold,
+		Market:    req.Market.Or(""),
+		Address:   req.Address.Or(""),
+		Category:  req.Category.Or(""),
+		ProductID: req.ProductID.Or(""),
+		Notifier:  domain.AlertNotifier(req.Notifier),
+		Target:    req.Target.Or(""),
+		Enabled:   req.Enabled.Or(true),
+	}
+}
+
+func toAlertRule(r *domain.AlertRule) httpgen.AlertRule {
+	res := httpgen.AlertRule{
+		ID:        r.ID,
+		Name:      r.Name,
+		Kind:      httpgen.AlertRuleKind(r.Kind),
+		Threshold: r.Threshold,
+		Notifier:  httpgen.AlertRuleNotifier(r.Notifier),
+		Enabled:   r.Enabled,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+	if r.Market != "" {
+		res.Market = httpgen.NewOptString(r.Market)
+	}
+	if r.Address != "" {
+		res.Address = httpgen.NewOptString(r.Address)
+	}
+	if r.Category != "" {
+		res.Category = httpgen.NewOptString(r.Category)
+	}
+	if r.ProductID != "" {
+		res.ProductID = httpgen.NewOptString(r.ProductID)
+	}
+	if r.Target != "" {
+		res.Target = httpgen.NewOptString(r.Target)
+	}
+
+	return res
+}
+
+func toCategories(res []domain.Category) []httpgen.Category {
+	categories := make([]httpgen.Category, 0, len(res))
+	for _, c := range res {
+		categories = append(categories, httpgen.Category{
+			ID:       c.ID,
+			Title:    c.Title,
+			Slug:     c.Slug,
+			URL:      c.URL,
+			Children: toCategories(c.Children),
+		})
+	}
+
+	return categories
+}
+
+// failedPages проверяет, вернул ли сервис товары разобранных страниц вместе с ошибкой остальных.
+// Такой ответ отдаётся со статусом 206, номера неразобранных страниц - в заголовке X-Failed-Pages.
+func (h *Handler) failedPages(products []domain.Products, err error) (httpgen.OptString, bool) {
+	pages := domain.FailedPages(err)
+	if pages == nil || len(products) == 0 {
+		return httpgen.OptString{}, false
+	}
+	h.logger.Warn("partial parse result", "failed_pages", pages, "products", len(products), "error", err)
+
+	nums := make([]string, 0, len(pages))
+	for _, p := range pages {
+		nums = append(nums, strconv.Itoa(p))
+	}
+
+	return httpgen.NewOptString(strings.Join(nums, ",")), true
+}
+
+func toParseResponse(res []domain.Products) httpgen.ParseResponse {
+	resp := make(httpgen.ParseResponse, 0, len(res))
+	for _, p := range res {
+		resp = append(resp, toProduct(p))
+	}
+
+	return resp
+}
+
+func toProduct(p domain.Products) httpgen.Product {
+	res := httpgen.Product{
+		Name:      p.Name,
+		Link:      p.URL,
+		Price:     p.Price,
+		ImageUrls: p.ImageURLs,
+	}
+	if p.ID != nil {
+		res.ID = httpgen.NewOptString(*p.ID)
+	}
+	if p.SKU != nil {
+		res.Sku = httpgen.NewOptString(*p.SKU)
+	}
+	if p.Brand != nil {
+		res.Brand = httpgen.NewOptString(*p.Brand)
+	}
+	if p.PackSize != nil {
+		res.PackSize = httpgen.NewOptFloat64(*p.PackSize)
+	}
+	if p.PackUnit != nil {
+		res.PackUnit = httpgen.NewOptString(*p.PackUnit)
+	}
+	if p.OriginalPrice != nil {
+		res.OriginalPrice = httpgen.NewOptFloat64(*p.OriginalPrice)
+	}
+	if p.DiscountPercent != nil {
+		res.DiscountPercent = httpgen.NewOptFloat64(*p.DiscountPercent)
+	}
+	if p.InStock != nil {
+		res.InStock = httpgen.NewOptBool(*p.InStock)
+	}
+	if p.MaxQuantity != nil {
+		res.MaxQuantity = httpgen.NewOptInt(*p.MaxQuantity)
+	}
+	if p.Rating != nil {
+		res.Rating = httpgen.NewOptFloat64(*p.Rating)
+	}
+
+	return res
+}
+
+func fromParseResponse(res httpgen.ParseResponse) []domain.Products {
+	products := make([]domain.Products, 0, len(res))
+	for _, p := range res {
+		products = append(products, fromProduct(p))
+	}
+
+	return products
+}
+
+func fromProduct(p httpgen.Product) domain.Products {
+	res := domain.Products{
+		Name:      p.Name,
+		URL:       p.Link,
+		Price:     p.Price,
+		ImageURLs: p.ImageUrls,
+	}
+	if v, ok := p.ID.Get(); ok {
+		res.ID = &v
+	}
+	if v, ok := p.Sku.Get(); ok {
+		res.SKU = &v
+	}
+	if v, ok := p.Brand.Get(); ok {
+		res.Brand = &v
+	}
+	if v, ok := p.PackSize.Get(); ok {
+		res.PackSize = &v
+	}
+	if v, ok := p.PackUnit.Get(); ok {
+		res.PackUnit = &v
+	}
+	if v, ok := p.OriginalPrice.Get(); ok {
+		res.OriginalPrice = &v
+	}
+	if v, ok := p.DiscountPercent.Get(); ok {
+		res.DiscountPercent = &v
+	}
+	if v, ok := p.InStock.Get(); ok {
+		res.InStock = &v
+	}
+	if v, ok := p.MaxQuantity.Get(); ok {
+		res.MaxQuantity = &v
+	}
+	if v, ok := p.Rating.Get(); ok {
+		res.Rating = &v
+	}
+
+	return res
+}
+
 func (h *Handler) LogHTTPError(ctx context.Context, err error, httpErr *HTTPError) {
 	attrs := []any{
 		"error", err,
@@ -53,10 +850,57 @@ func (h *Handler) LogHTTPError(ctx context.Context, err error, httpErr *HTTPErro
 		switch httpErr.Status {
 		case http.StatusGatewayTimeout:
 			h.logger.Error("http_request_failed", append(attrs, "reason", "dependency_timeout")...)
+		case http.StatusServiceUnavailable:
+			if errors.Is(err, domain.ErrCaptchaBlocked) {
+				h.logger.Error("http_request_failed", append(attrs, "reason", "captcha_blocked")...)
+			} else {
+				h.logger.Error("http_request_failed", append(attrs, "reason", "service_unavailable")...)
+			}
 		default:
 			h.logger.Error("http_request_failed", append(attrs, "reason", "internal_server_error")...)
 		}
 	case httpErr.Status >= 400:
 		h.logger.Warn("http_request_failed", append(attrs, "reason", "client_error")...)
 	}
-}
\ No newline at end of file
+}
+
+func (h *Handler) APIV1DiagnosticsSelectorsGet(ctx context.Context, params httpgen.APIV1DiagnosticsSelectorsGetParams) (httpgen.APIV1DiagnosticsSelectorsGetRes, error) {
+	res, err := h.diagnosticsSrv.CheckSelectors(ctx, params.Provider.Or(""), params.Market, params.Address, params.Category.Or(""))
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToSelectorsErrRes(), nil
+	}
+
+	resp := httpgen.SelectorReport{
+		Provider:   res.Provider,
+		Market:     res.Market,
+		Address:    res.Address,
+		Healthy:    res.Healthy(),
+		StartedAt:  res.StartedAt,
+		DurationMs: res.Duration.Milliseconds(),
+		Checks:     make([]httpgen.SelectorCheck, 0, len(res.Checks)),
+	}
+	if res.Category != "" {
+		resp.Category = httpgen.NewOptString(res.Category)
+	}
+	for _, c := range res.Checks {
+		check := httpgen.SelectorCheck{
+			Name:       c.Name,
+			Selector:   c.Selector,
+			Page:       httpgen.SelectorCheckPage(c.Page),
+			Required:   c.Required,
+			Status:     httpgen.SelectorCheckStatus(c.Status),
+			DurationMs: c.Duration.Milliseconds(),
+		}
+		if c.Err != "" {
+			check.Error = httpgen.NewOptString(c.Err)
+		}
+		if params.Screenshots.Or(true) {
+			check.Screenshot = c.Screenshot
+		}
+		resp.Checks = append(resp.Checks, check)
+	}
+
+	return &resp, nil
+}
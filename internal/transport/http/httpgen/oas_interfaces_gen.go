@@ -1,6 +1,94 @@
 // Code generated by ogen, DO NOT EDIT.
 package httpgen
 
+type APIV1AlertsGetRes interface {
+	aPIV1AlertsGetRes()
+}
+
+type APIV1AlertsIDDeleteRes interface {
+	aPIV1AlertsIDDeleteRes()
+}
+
+type APIV1AlertsIDGetRes interface {
+	aPIV1AlertsIDGetRes()
+}
+
+type APIV1AlertsIDPutRes interface {
+	aPIV1AlertsIDPutRes()
+}
+
+type APIV1AlertsPostRes interface {
+	aPIV1AlertsPostRes()
+}
+
+type APIV1DiagnosticsSelectorsGetRes interface {
+	aPIV1DiagnosticsSelectorsGetRes()
+}
+
+type APIV1DiffGetRes interface {
+	aPIV1DiffGetRes()
+}
+
+type APIV1DiffPostRes interface {
+	aPIV1DiffPostRes()
+}
+
+type APIV1JobsIDDeleteRes interface {
+	aPIV1JobsIDDeleteRes()
+}
+
+type APIV1JobsIDGetRes interface {
+	aPIV1JobsIDGetRes()
+}
+
+type APIV1JobsPostRes interface {
+	aPIV1JobsPostRes()
+}
+
+type APIV1MarketParserCategoriesGetRes interface {
+	aPIV1MarketParserCategoriesGetRes()
+}
+
+type APIV1MarketParserMarketsGetRes interface {
+	aPIV1MarketParserMarketsGetRes()
+}
+
 type APIV1MarketParserParseGetRes interface {
 	aPIV1MarketParserParseGetRes()
 }
+
+type APIV1MarketParserParseMarketsGetRes interface {
+	aPIV1MarketParserParseMarketsGetRes()
+}
+
+type APIV1MarketParserSearchGetRes interface {
+	aPIV1MarketParserSearchGetRes()
+}
+
+type APIV1ProductsIDHistoryGetRes interface {
+	aPIV1ProductsIDHistoryGetRes()
+}
+
+type APIV1SchedulesGetRes interface {
+	aPIV1SchedulesGetRes()
+}
+
+type APIV1SchedulesNameRunsGetRes interface {
+	aPIV1SchedulesNameRunsGetRes()
+}
+
+type APIV1SnapshotsGetRes interface {
+	aPIV1SnapshotsGetRes()
+}
+
+type APIV1SnapshotsIDGetRes interface {
+	aPIV1SnapshotsIDGetRes()
+}
+
+type APIV1WebhooksDeliveriesGetRes interface {
+	aPIV1WebhooksDeliveriesGetRes()
+}
+
+type APIV1WebhooksDeliveriesIDGetRes interface {
+	aPIV1WebhooksDeliveriesIDGetRes()
+}
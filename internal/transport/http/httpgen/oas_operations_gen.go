@@ -6,5 +6,27 @@ package httpgen
 type OperationName = string
 
 const (
-	APIV1MarketParserParseGetOperation OperationName = "APIV1MarketParserParseGet"
+	APIV1AlertsGetOperation                   OperationName = "APIV1AlertsGet"
+	APIV1AlertsIDDeleteOperation              OperationName = "APIV1AlertsIDDelete"
+	APIV1AlertsIDGetOperation                 OperationName = "APIV1AlertsIDGet"
+	APIV1AlertsIDPutOperation                 OperationName = "APIV1AlertsIDPut"
+	APIV1AlertsPostOperation                  OperationName = "APIV1AlertsPost"
+	APIV1DiagnosticsSelectorsGetOperation     OperationName = "APIV1DiagnosticsSelectorsGet"
+	APIV1DiffGetOperation                     OperationName = "APIV1DiffGet"
+	APIV1DiffPostOperation                    OperationName = "APIV1DiffPost"
+	APIV1JobsIDDeleteOperation                OperationName = "APIV1JobsIDDelete"
+	APIV1JobsIDGetOperation                   OperationName = "APIV1JobsIDGet"
+	APIV1JobsPostOperation                    OperationName = "APIV1JobsPost"
+	APIV1MarketParserCategoriesGetOperation   OperationName = "APIV1MarketParserCategoriesGet"
+	APIV1MarketParserMarketsGetOperation      OperationName = "APIV1MarketParserMarketsGet"
+	APIV1MarketParserParseGetOperation        OperationName = "APIV1MarketParserParseGet"
+	APIV1MarketParserParseMarketsGetOperation OperationName = "APIV1MarketParserParseMarketsGet"
+	APIV1MarketParserSearchGetOperation       OperationName = "APIV1MarketParserSearchGet"
+	APIV1ProductsIDHistoryGetOperation        OperationName = "APIV1ProductsIDHistoryGet"
+	APIV1SchedulesGetOperation                OperationName = "APIV1SchedulesGet"
+	APIV1SchedulesNameRunsGetOperation        OperationName = "APIV1SchedulesNameRunsGet"
+	APIV1SnapshotsGetOperation                OperationName = "APIV1SnapshotsGet"
+	APIV1SnapshotsIDGetOperation              OperationName = "APIV1SnapshotsIDGet"
+	APIV1WebhooksDeliveriesGetOperation       OperationName = "APIV1WebhooksDeliveriesGet"
+	APIV1WebhooksDeliveriesIDGetOperation     OperationName = "APIV1WebhooksDeliveriesIDGet"
 )
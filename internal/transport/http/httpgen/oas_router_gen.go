@@ -40,6 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
+	args := [1]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -48,24 +49,536 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/api/v1/market-parser/parse"
+		case '/': // Prefix: "/api/v1/"
 
-			if l := len("/api/v1/market-parser/parse"); len(elem) >= l && elem[0:l] == "/api/v1/market-parser/parse" {
+			if l := len("/api/v1/"); len(elem) >= l && elem[0:l] == "/api/v1/" {
 				elem = elem[l:]
 			} else {
 				break
 			}
 
 			if len(elem) == 0 {
-				// Leaf node.
-				switch r.Method {
-				case "GET":
-					s.handleAPIV1MarketParserParseGetRequest([0]string{}, elemIsEscaped, w, r)
-				default:
-					s.notAllowed(w, r, "GET")
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "alerts"
+
+				if l := len("alerts"); len(elem) >= l && elem[0:l] == "alerts" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleAPIV1AlertsGetRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleAPIV1AlertsPostRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET,POST")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "DELETE":
+							s.handleAPIV1AlertsIDDeleteRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "GET":
+							s.handleAPIV1AlertsIDGetRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handleAPIV1AlertsIDPutRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,GET,PUT")
+						}
+
+						return
+					}
+
+				}
+
+			case 'd': // Prefix: "di"
+
+				if l := len("di"); len(elem) >= l && elem[0:l] == "di" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "agnostics/selectors"
+
+					if l := len("agnostics/selectors"); len(elem) >= l && elem[0:l] == "agnostics/selectors" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleAPIV1DiagnosticsSelectorsGetRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				case 'f': // Prefix: "ff"
+
+					if l := len("ff"); len(elem) >= l && elem[0:l] == "ff" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleAPIV1DiffGetRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleAPIV1DiffPostRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+
+				}
+
+			case 'j': // Prefix: "jobs"
+
+				if l := len("jobs"); len(elem) >= l && elem[0:l] == "jobs" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "POST":
+						s.handleAPIV1JobsPostRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "POST")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "DELETE":
+							s.handleAPIV1JobsIDDeleteRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "GET":
+							s.handleAPIV1JobsIDGetRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,GET")
+						}
+
+						return
+					}
+
+				}
+
+			case 'm': // Prefix: "market-parser/"
+
+				if l := len("market-parser/"); len(elem) >= l && elem[0:l] == "market-parser/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "categories"
+
+					if l := len("categories"); len(elem) >= l && elem[0:l] == "categories" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleAPIV1MarketParserCategoriesGetRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				case 'm': // Prefix: "markets"
+
+					if l := len("markets"); len(elem) >= l && elem[0:l] == "markets" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleAPIV1MarketParserMarketsGetRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				case 'p': // Prefix: "parse"
+
+					if l := len("parse"); len(elem) >= l && elem[0:l] == "parse" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleAPIV1MarketParserParseGetRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/markets"
+
+						if l := len("/markets"); len(elem) >= l && elem[0:l] == "/markets" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleAPIV1MarketParserParseMarketsGetRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					}
+
+				case 's': // Prefix: "search"
+
+					if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleAPIV1MarketParserSearchGetRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				}
+
+			case 'p': // Prefix: "products/"
+
+				if l := len("products/"); len(elem) >= l && elem[0:l] == "products/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				// Param: "id"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/history"
+
+					if l := len("/history"); len(elem) >= l && elem[0:l] == "/history" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleAPIV1ProductsIDHistoryGetRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				}
+
+			case 's': // Prefix: "s"
+
+				if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "chedules"
+
+					if l := len("chedules"); len(elem) >= l && elem[0:l] == "chedules" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleAPIV1SchedulesGetRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "name"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/runs"
+
+							if l := len("/runs"); len(elem) >= l && elem[0:l] == "/runs" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleAPIV1SchedulesNameRunsGetRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				case 'n': // Prefix: "napshots"
+
+					if l := len("napshots"); len(elem) >= l && elem[0:l] == "napshots" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleAPIV1SnapshotsGetRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleAPIV1SnapshotsIDGetRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					}
+
+				}
+
+			case 'w': // Prefix: "webhooks/deliveries"
+
+				if l := len("webhooks/deliveries"); len(elem) >= l && elem[0:l] == "webhooks/deliveries" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleAPIV1WebhooksDeliveriesGetRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleAPIV1WebhooksDeliveriesIDGetRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
 				}
 
-				return
 			}
 
 		}
@@ -81,7 +594,7 @@ type Route struct {
 	operationGroup string
 	pathPattern    string
 	count          int
-	args           [0]string
+	args           [1]string
 }
 
 // Name returns ogen operation name.
@@ -154,29 +667,643 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/api/v1/market-parser/parse"
+		case '/': // Prefix: "/api/v1/"
 
-			if l := len("/api/v1/market-parser/parse"); len(elem) >= l && elem[0:l] == "/api/v1/market-parser/parse" {
+			if l := len("/api/v1/"); len(elem) >= l && elem[0:l] == "/api/v1/" {
 				elem = elem[l:]
 			} else {
 				break
 			}
 
 			if len(elem) == 0 {
-				// Leaf node.
-				switch method {
-				case "GET":
-					r.name = APIV1MarketParserParseGetOperation
-					r.summary = "Parse category."
-					r.operationID = ""
-					r.operationGroup = ""
-					r.pathPattern = "/api/v1/market-parser/parse"
-					r.args = args
-					r.count = 0
-					return r, true
-				default:
-					return
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "alerts"
+
+				if l := len("alerts"); len(elem) >= l && elem[0:l] == "alerts" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = APIV1AlertsGetOperation
+						r.summary = "Alert rules."
+						r.operationID = ""
+						r.operationGroup = ""
+						r.pathPattern = "/api/v1/alerts"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = APIV1AlertsPostOperation
+						r.summary = "Create alert rule."
+						r.operationID = ""
+						r.operationGroup = ""
+						r.pathPattern = "/api/v1/alerts"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "DELETE":
+							r.name = APIV1AlertsIDDeleteOperation
+							r.summary = "Delete alert rule."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/alerts/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "GET":
+							r.name = APIV1AlertsIDGetOperation
+							r.summary = "Alert rule."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/alerts/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "PUT":
+							r.name = APIV1AlertsIDPutOperation
+							r.summary = "Replace alert rule."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/alerts/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 'd': // Prefix: "di"
+
+				if l := len("di"); len(elem) >= l && elem[0:l] == "di" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "agnostics/selectors"
+
+					if l := len("agnostics/selectors"); len(elem) >= l && elem[0:l] == "agnostics/selectors" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = APIV1DiagnosticsSelectorsGetOperation
+							r.summary = "Check the configured selectors against the live site."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/diagnostics/selectors"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 'f': // Prefix: "ff"
+
+					if l := len("ff"); len(elem) >= l && elem[0:l] == "ff" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = APIV1DiffGetOperation
+							r.summary = "Compare two snapshots."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/diff"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = APIV1DiffPostOperation
+							r.summary = "Compare two parse outputs."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/diff"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 'j': // Prefix: "jobs"
+
+				if l := len("jobs"); len(elem) >= l && elem[0:l] == "jobs" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "POST":
+						r.name = APIV1JobsPostOperation
+						r.summary = "Create parse job."
+						r.operationID = ""
+						r.operationGroup = ""
+						r.pathPattern = "/api/v1/jobs"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "DELETE":
+							r.name = APIV1JobsIDDeleteOperation
+							r.summary = "Cancel job."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/jobs/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "GET":
+							r.name = APIV1JobsIDGetOperation
+							r.summary = "Job status."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/jobs/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 'm': // Prefix: "market-parser/"
+
+				if l := len("market-parser/"); len(elem) >= l && elem[0:l] == "market-parser/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "categories"
+
+					if l := len("categories"); len(elem) >= l && elem[0:l] == "categories" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = APIV1MarketParserCategoriesGetOperation
+							r.summary = "Category tree of a market."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/market-parser/categories"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 'm': // Prefix: "markets"
+
+					if l := len("markets"); len(elem) >= l && elem[0:l] == "markets" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = APIV1MarketParserMarketsGetOperation
+							r.summary = "Markets available for an address."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/market-parser/markets"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 'p': // Prefix: "parse"
+
+					if l := len("parse"); len(elem) >= l && elem[0:l] == "parse" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = APIV1MarketParserParseGetOperation
+							r.summary = "Parse category."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/market-parser/parse"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/markets"
+
+						if l := len("/markets"); len(elem) >= l && elem[0:l] == "/markets" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = APIV1MarketParserParseMarketsGetOperation
+								r.summary = "Parse category in several markets."
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/market-parser/parse/markets"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 's': // Prefix: "search"
+
+					if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = APIV1MarketParserSearchGetOperation
+							r.summary = "Search products."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/market-parser/search"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 'p': // Prefix: "products/"
+
+				if l := len("products/"); len(elem) >= l && elem[0:l] == "products/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				// Param: "id"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/history"
+
+					if l := len("/history"); len(elem) >= l && elem[0:l] == "/history" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = APIV1ProductsIDHistoryGetOperation
+							r.summary = "Product price history."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/products/{id}/history"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 's': // Prefix: "s"
+
+				if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "chedules"
+
+					if l := len("chedules"); len(elem) >= l && elem[0:l] == "chedules" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = APIV1SchedulesGetOperation
+							r.summary = "Schedules status."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/schedules"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "name"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/runs"
+
+							if l := len("/runs"); len(elem) >= l && elem[0:l] == "/runs" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = APIV1SchedulesNameRunsGetOperation
+									r.summary = "Schedule run history."
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/schedules/{name}/runs"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
+				case 'n': // Prefix: "napshots"
+
+					if l := len("napshots"); len(elem) >= l && elem[0:l] == "napshots" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = APIV1SnapshotsGetOperation
+							r.summary = "Saved parse results."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/snapshots"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = APIV1SnapshotsIDGetOperation
+								r.summary = "Saved parse result."
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/snapshots/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				}
+
+			case 'w': // Prefix: "webhooks/deliveries"
+
+				if l := len("webhooks/deliveries"); len(elem) >= l && elem[0:l] == "webhooks/deliveries" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = APIV1WebhooksDeliveriesGetOperation
+						r.summary = "Webhook delivery log."
+						r.operationID = ""
+						r.operationGroup = ""
+						r.pathPattern = "/api/v1/webhooks/deliveries"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = APIV1WebhooksDeliveriesIDGetOperation
+							r.summary = "Webhook delivery."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/webhooks/deliveries/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
 			}
 
 		}
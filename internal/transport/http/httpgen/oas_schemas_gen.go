@@ -2,6 +2,150 @@
 
 package httpgen
 
+import (
+	"io"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+type APIV1AlertsIDDeleteInternalServerError ErrorResponse
+
+func (*APIV1AlertsIDDeleteInternalServerError) aPIV1AlertsIDDeleteRes() {}
+
+// APIV1AlertsIDDeleteNoContent is response for APIV1AlertsIDDelete operation.
+type APIV1AlertsIDDeleteNoContent struct{}
+
+func (*APIV1AlertsIDDeleteNoContent) aPIV1AlertsIDDeleteRes() {}
+
+type APIV1AlertsIDDeleteNotFound ErrorResponse
+
+func (*APIV1AlertsIDDeleteNotFound) aPIV1AlertsIDDeleteRes() {}
+
+type APIV1AlertsIDGetInternalServerError ErrorResponse
+
+func (*APIV1AlertsIDGetInternalServerError) aPIV1AlertsIDGetRes() {}
+
+type APIV1AlertsIDGetNotFound ErrorResponse
+
+func (*APIV1AlertsIDGetNotFound) aPIV1AlertsIDGetRes() {}
+
+type APIV1AlertsIDPutBadRequest ErrorResponse
+
+func (*APIV1AlertsIDPutBadRequest) aPIV1AlertsIDPutRes() {}
+
+type APIV1AlertsIDPutInternalServerError ErrorResponse
+
+func (*APIV1AlertsIDPutInternalServerError) aPIV1AlertsIDPutRes() {}
+
+type APIV1AlertsIDPutNotFound ErrorResponse
+
+func (*APIV1AlertsIDPutNotFound) aPIV1AlertsIDPutRes() {}
+
+type APIV1AlertsPostBadRequest ErrorResponse
+
+func (*APIV1AlertsPostBadRequest) aPIV1AlertsPostRes() {}
+
+type APIV1AlertsPostInternalServerError ErrorResponse
+
+func (*APIV1AlertsPostInternalServerError) aPIV1AlertsPostRes() {}
+
+type APIV1DiagnosticsSelectorsGetBadRequest ErrorResponse
+
+func (*APIV1DiagnosticsSelectorsGetBadRequest) aPIV1DiagnosticsSelectorsGetRes() {}
+
+type APIV1DiagnosticsSelectorsGetCode499 ErrorResponse
+
+func (*APIV1DiagnosticsSelectorsGetCode499) aPIV1DiagnosticsSelectorsGetRes() {}
+
+type APIV1DiagnosticsSelectorsGetGatewayTimeout ErrorResponse
+
+func (*APIV1DiagnosticsSelectorsGetGatewayTimeout) aPIV1DiagnosticsSelectorsGetRes() {}
+
+type APIV1DiagnosticsSelectorsGetInternalServerError ErrorResponse
+
+func (*APIV1DiagnosticsSelectorsGetInternalServerError) aPIV1DiagnosticsSelectorsGetRes() {}
+
+type APIV1DiffGetBadRequest ErrorResponse
+
+func (*APIV1DiffGetBadRequest) aPIV1DiffGetRes() {}
+
+type APIV1DiffGetInternalServerError ErrorResponse
+
+func (*APIV1DiffGetInternalServerError) aPIV1DiffGetRes() {}
+
+type APIV1DiffGetNotFound ErrorResponse
+
+func (*APIV1DiffGetNotFound) aPIV1DiffGetRes() {}
+
+type APIV1DiffPostBadRequest ErrorResponse
+
+func (*APIV1DiffPostBadRequest) aPIV1DiffPostRes() {}
+
+type APIV1DiffPostInternalServerError ErrorResponse
+
+func (*APIV1DiffPostInternalServerError) aPIV1DiffPostRes() {}
+
+type APIV1JobsIDDeleteInternalServerError ErrorResponse
+
+func (*APIV1JobsIDDeleteInternalServerError) aPIV1JobsIDDeleteRes() {}
+
+type APIV1JobsIDDeleteNotFound ErrorResponse
+
+func (*APIV1JobsIDDeleteNotFound) aPIV1JobsIDDeleteRes() {}
+
+type APIV1JobsIDGetInternalServerError ErrorResponse
+
+func (*APIV1JobsIDGetInternalServerError) aPIV1JobsIDGetRes() {}
+
+type APIV1JobsIDGetNotFound ErrorResponse
+
+func (*APIV1JobsIDGetNotFound) aPIV1JobsIDGetRes() {}
+
+type APIV1JobsPostBadRequest ErrorResponse
+
+func (*APIV1JobsPostBadRequest) aPIV1JobsPostRes() {}
+
+type APIV1JobsPostInternalServerError ErrorResponse
+
+func (*APIV1JobsPostInternalServerError) aPIV1JobsPostRes() {}
+
+type APIV1JobsPostServiceUnavailable ErrorResponse
+
+func (*APIV1JobsPostServiceUnavailable) aPIV1JobsPostRes() {}
+
+type APIV1MarketParserCategoriesGetBadRequest ErrorResponse
+
+func (*APIV1MarketParserCategoriesGetBadRequest) aPIV1MarketParserCategoriesGetRes() {}
+
+type APIV1MarketParserCategoriesGetCode499 ErrorResponse
+
+func (*APIV1MarketParserCategoriesGetCode499) aPIV1MarketParserCategoriesGetRes() {}
+
+type APIV1MarketParserCategoriesGetGatewayTimeout ErrorResponse
+
+func (*APIV1MarketParserCategoriesGetGatewayTimeout) aPIV1MarketParserCategoriesGetRes() {}
+
+type APIV1MarketParserCategoriesGetInternalServerError ErrorResponse
+
+func (*APIV1MarketParserCategoriesGetInternalServerError) aPIV1MarketParserCategoriesGetRes() {}
+
+type APIV1MarketParserMarketsGetBadRequest ErrorResponse
+
+func (*APIV1MarketParserMarketsGetBadRequest) aPIV1MarketParserMarketsGetRes() {}
+
+type APIV1MarketParserMarketsGetCode499 ErrorResponse
+
+func (*APIV1MarketParserMarketsGetCode499) aPIV1MarketParserMarketsGetRes() {}
+
+type APIV1MarketParserMarketsGetGatewayTimeout ErrorResponse
+
+func (*APIV1MarketParserMarketsGetGatewayTimeout) aPIV1MarketParserMarketsGetRes() {}
+
+type APIV1MarketParserMarketsGetInternalServerError ErrorResponse
+
+func (*APIV1MarketParserMarketsGetInternalServerError) aPIV1MarketParserMarketsGetRes() {}
+
 type APIV1MarketParserParseGetBadRequest ErrorResponse
 
 func (*APIV1MarketParserParseGetBadRequest) aPIV1MarketParserParseGetRes() {}
@@ -10,6 +154,61 @@ type APIV1MarketParserParseGetCode499 ErrorResponse
 
 func (*APIV1MarketParserParseGetCode499) aPIV1MarketParserParseGetRes() {}
 
+type APIV1MarketParserParseGetFormat string
+
+const (
+	APIV1MarketParserParseGetFormatJSON    APIV1MarketParserParseGetFormat = "json"
+	APIV1MarketParserParseGetFormatCsv     APIV1MarketParserParseGetFormat = "csv"
+	APIV1MarketParserParseGetFormatXlsx    APIV1MarketParserParseGetFormat = "xlsx"
+	APIV1MarketParserParseGetFormatParquet APIV1MarketParserParseGetFormat = "parquet"
+)
+
+// AllValues returns all APIV1MarketParserParseGetFormat values.
+func (APIV1MarketParserParseGetFormat) AllValues() []APIV1MarketParserParseGetFormat {
+	return []APIV1MarketParserParseGetFormat{
+		APIV1MarketParserParseGetFormatJSON,
+		APIV1MarketParserParseGetFormatCsv,
+		APIV1MarketParserParseGetFormatXlsx,
+		APIV1MarketParserParseGetFormatParquet,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s APIV1MarketParserParseGetFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case APIV1MarketParserParseGetFormatJSON:
+		return []byte(s), nil
+	case APIV1MarketParserParseGetFormatCsv:
+		return []byte(s), nil
+	case APIV1MarketParserParseGetFormatXlsx:
+		return []byte(s), nil
+	case APIV1MarketParserParseGetFormatParquet:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *APIV1MarketParserParseGetFormat) UnmarshalText(data []byte) error {
+	switch APIV1MarketParserParseGetFormat(data) {
+	case APIV1MarketParserParseGetFormatJSON:
+		*s = APIV1MarketParserParseGetFormatJSON
+		return nil
+	case APIV1MarketParserParseGetFormatCsv:
+		*s = APIV1MarketParserParseGetFormatCsv
+		return nil
+	case APIV1MarketParserParseGetFormatXlsx:
+		*s = APIV1MarketParserParseGetFormatXlsx
+		return nil
+	case APIV1MarketParserParseGetFormatParquet:
+		*s = APIV1MarketParserParseGetFormatParquet
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 type APIV1MarketParserParseGetGatewayTimeout ErrorResponse
 
 func (*APIV1MarketParserParseGetGatewayTimeout) aPIV1MarketParserParseGetRes() {}
@@ -18,69 +217,3796 @@ type APIV1MarketParserParseGetInternalServerError ErrorResponse
 
 func (*APIV1MarketParserParseGetInternalServerError) aPIV1MarketParserParseGetRes() {}
 
-// Ref: #/components/schemas/ErrorResponse
-type ErrorResponse struct {
-	Status  int    `json:"status"`
-	Message string `json:"message"`
+type APIV1MarketParserParseGetMode string
+
+const (
+	APIV1MarketParserParseGetModeBrowser APIV1MarketParserParseGetMode = "browser"
+	APIV1MarketParserParseGetModeAPI     APIV1MarketParserParseGetMode = "api"
+)
+
+// AllValues returns all APIV1MarketParserParseGetMode values.
+func (APIV1MarketParserParseGetMode) AllValues() []APIV1MarketParserParseGetMode {
+	return []APIV1MarketParserParseGetMode{
+		APIV1MarketParserParseGetModeBrowser,
+		APIV1MarketParserParseGetModeAPI,
+	}
 }
 
-// GetStatus returns the value of Status.
-func (s *ErrorResponse) GetStatus() int {
-	return s.Status
+// MarshalText implements encoding.TextMarshaler.
+func (s APIV1MarketParserParseGetMode) MarshalText() ([]byte, error) {
+	switch s {
+	case APIV1MarketParserParseGetModeBrowser:
+		return []byte(s), nil
+	case APIV1MarketParserParseGetModeAPI:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetMessage returns the value of Message.
-func (s *ErrorResponse) GetMessage() string {
-	return s.Message
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *APIV1MarketParserParseGetMode) UnmarshalText(data []byte) error {
+	switch APIV1MarketParserParseGetMode(data) {
+	case APIV1MarketParserParseGetModeBrowser:
+		*s = APIV1MarketParserParseGetModeBrowser
+		return nil
+	case APIV1MarketParserParseGetModeAPI:
+		*s = APIV1MarketParserParseGetModeAPI
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetStatus sets the value of Status.
-func (s *ErrorResponse) SetStatus(val int) {
-	s.Status = val
+type APIV1MarketParserParseGetOKApplicationVndApacheParquet struct {
+	Data io.Reader
 }
 
-// SetMessage sets the value of Message.
-func (s *ErrorResponse) SetMessage(val string) {
-	s.Message = val
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s APIV1MarketParserParseGetOKApplicationVndApacheParquet) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-type ParseResponse []Product
+func (*APIV1MarketParserParseGetOKApplicationVndApacheParquet) aPIV1MarketParserParseGetRes() {}
 
-func (*ParseResponse) aPIV1MarketParserParseGetRes() {}
+type APIV1MarketParserParseGetOKApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet struct {
+	Data io.Reader
+}
 
-// Ref: #/components/schemas/Product
-type Product struct {
-	Name  string  `json:"name"`
-	Link  string  `json:"link"`
-	Price float64 `json:"price"`
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s APIV1MarketParserParseGetOKApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetName returns the value of Name.
-func (s *Product) GetName() string {
-	return s.Name
+func (*APIV1MarketParserParseGetOKApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet) aPIV1MarketParserParseGetRes() {
 }
 
-// GetLink returns the value of Link.
-func (s *Product) GetLink() string {
-	return s.Link
+type APIV1MarketParserParseGetOKTextCsv struct {
+	Data io.Reader
 }
 
-// GetPrice returns the value of Price.
-func (s *Product) GetPrice() float64 {
-	return s.Price
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s APIV1MarketParserParseGetOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// SetName sets the value of Name.
-func (s *Product) SetName(val string) {
-	s.Name = val
+func (*APIV1MarketParserParseGetOKTextCsv) aPIV1MarketParserParseGetRes() {}
+
+type APIV1MarketParserParseGetPartialContentApplicationVndApacheParquet struct {
+	Data io.Reader
 }
 
-// SetLink sets the value of Link.
-func (s *Product) SetLink(val string) {
-	s.Link = val
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s APIV1MarketParserParseGetPartialContentApplicationVndApacheParquet) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// SetPrice sets the value of Price.
-func (s *Product) SetPrice(val float64) {
-	s.Price = val
+// APIV1MarketParserParseGetPartialContentApplicationVndApacheParquetHeaders wraps APIV1MarketParserParseGetPartialContentApplicationVndApacheParquet with response headers.
+type APIV1MarketParserParseGetPartialContentApplicationVndApacheParquetHeaders struct {
+	XFailedPages OptString
+	Response     APIV1MarketParserParseGetPartialContentApplicationVndApacheParquet
+}
+
+// GetXFailedPages returns the value of XFailedPages.
+func (s *APIV1MarketParserParseGetPartialContentApplicationVndApacheParquetHeaders) GetXFailedPages() OptString {
+	return s.XFailedPages
+}
+
+// GetResponse returns the value of Response.
+func (s *APIV1MarketParserParseGetPartialContentApplicationVndApacheParquetHeaders) GetResponse() APIV1MarketParserParseGetPartialContentApplicationVndApacheParquet {
+	return s.Response
+}
+
+// SetXFailedPages sets the value of XFailedPages.
+func (s *APIV1MarketParserParseGetPartialContentApplicationVndApacheParquetHeaders) SetXFailedPages(val OptString) {
+	s.XFailedPages = val
+}
+
+// SetResponse sets the value of Response.
+func (s *APIV1MarketParserParseGetPartialContentApplicationVndApacheParquetHeaders) SetResponse(val APIV1MarketParserParseGetPartialContentApplicationVndApacheParquet) {
+	s.Response = val
+}
+
+func (*APIV1MarketParserParseGetPartialContentApplicationVndApacheParquetHeaders) aPIV1MarketParserParseGetRes() {
+}
+
+type APIV1MarketParserParseGetPartialContentApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s APIV1MarketParserParseGetPartialContentApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// APIV1MarketParserParseGetPartialContentApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheetHeaders wraps APIV1MarketParserParseGetPartialContentApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet with response headers.
+type APIV1MarketParserParseGetPartialContentApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheetHeaders struct {
+	XFailedPages OptString
+	Response     APIV1MarketParserParseGetPartialContentApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet
+}
+
+// GetXFailedPages returns the value of XFailedPages.
+func (s *APIV1MarketParserParseGetPartialContentApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheetHeaders) GetXFailedPages() OptString {
+	return s.XFailedPages
+}
+
+// GetResponse returns the value of Response.
+func (s *APIV1MarketParserParseGetPartialContentApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheetHeaders) GetResponse() APIV1MarketParserParseGetPartialContentApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet {
+	return s.Response
+}
+
+// SetXFailedPages sets the value of XFailedPages.
+func (s *APIV1MarketParserParseGetPartialContentApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheetHeaders) SetXFailedPages(val OptString) {
+	s.XFailedPages = val
+}
+
+// SetResponse sets the value of Response.
+func (s *APIV1MarketParserParseGetPartialContentApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheetHeaders) SetResponse(val APIV1MarketParserParseGetPartialContentApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet) {
+	s.Response = val
+}
+
+func (*APIV1MarketParserParseGetPartialContentApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheetHeaders) aPIV1MarketParserParseGetRes() {
+}
+
+type APIV1MarketParserParseGetPartialContentTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s APIV1MarketParserParseGetPartialContentTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// APIV1MarketParserParseGetPartialContentTextCsvHeaders wraps APIV1MarketParserParseGetPartialContentTextCsv with response headers.
+type APIV1MarketParserParseGetPartialContentTextCsvHeaders struct {
+	XFailedPages OptString
+	Response     APIV1MarketParserParseGetPartialContentTextCsv
+}
+
+// GetXFailedPages returns the value of XFailedPages.
+func (s *APIV1MarketParserParseGetPartialContentTextCsvHeaders) GetXFailedPages() OptString {
+	return s.XFailedPages
+}
+
+// GetResponse returns the value of Response.
+func (s *APIV1MarketParserParseGetPartialContentTextCsvHeaders) GetResponse() APIV1MarketParserParseGetPartialContentTextCsv {
+	return s.Response
+}
+
+// SetXFailedPages sets the value of XFailedPages.
+func (s *APIV1MarketParserParseGetPartialContentTextCsvHeaders) SetXFailedPages(val OptString) {
+	s.XFailedPages = val
+}
+
+// SetResponse sets the value of Response.
+func (s *APIV1MarketParserParseGetPartialContentTextCsvHeaders) SetResponse(val APIV1MarketParserParseGetPartialContentTextCsv) {
+	s.Response = val
+}
+
+func (*APIV1MarketParserParseGetPartialContentTextCsvHeaders) aPIV1MarketParserParseGetRes() {}
+
+type APIV1MarketParserParseMarketsGetBadRequest ErrorResponse
+
+func (*APIV1MarketParserParseMarketsGetBadRequest) aPIV1MarketParserParseMarketsGetRes() {}
+
+type APIV1MarketParserParseMarketsGetCode499 ErrorResponse
+
+func (*APIV1MarketParserParseMarketsGetCode499) aPIV1MarketParserParseMarketsGetRes() {}
+
+type APIV1MarketParserParseMarketsGetGatewayTimeout ErrorResponse
+
+func (*APIV1MarketParserParseMarketsGetGatewayTimeout) aPIV1MarketParserParseMarketsGetRes() {}
+
+type APIV1MarketParserParseMarketsGetInternalServerError ErrorResponse
+
+func (*APIV1MarketParserParseMarketsGetInternalServerError) aPIV1MarketParserParseMarketsGetRes() {}
+
+type APIV1MarketParserParseMarketsGetMode string
+
+const (
+	APIV1MarketParserParseMarketsGetModeBrowser APIV1MarketParserParseMarketsGetMode = "browser"
+	APIV1MarketParserParseMarketsGetModeAPI     APIV1MarketParserParseMarketsGetMode = "api"
+)
+
+// AllValues returns all APIV1MarketParserParseMarketsGetMode values.
+func (APIV1MarketParserParseMarketsGetMode) AllValues() []APIV1MarketParserParseMarketsGetMode {
+	return []APIV1MarketParserParseMarketsGetMode{
+		APIV1MarketParserParseMarketsGetModeBrowser,
+		APIV1MarketParserParseMarketsGetModeAPI,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s APIV1MarketParserParseMarketsGetMode) MarshalText() ([]byte, error) {
+	switch s {
+	case APIV1MarketParserParseMarketsGetModeBrowser:
+		return []byte(s), nil
+	case APIV1MarketParserParseMarketsGetModeAPI:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *APIV1MarketParserParseMarketsGetMode) UnmarshalText(data []byte) error {
+	switch APIV1MarketParserParseMarketsGetMode(data) {
+	case APIV1MarketParserParseMarketsGetModeBrowser:
+		*s = APIV1MarketParserParseMarketsGetModeBrowser
+		return nil
+	case APIV1MarketParserParseMarketsGetModeAPI:
+		*s = APIV1MarketParserParseMarketsGetModeAPI
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type APIV1MarketParserSearchGetBadRequest ErrorResponse
+
+func (*APIV1MarketParserSearchGetBadRequest) aPIV1MarketParserSearchGetRes() {}
+
+type APIV1MarketParserSearchGetCode499 ErrorResponse
+
+func (*APIV1MarketParserSearchGetCode499) aPIV1MarketParserSearchGetRes() {}
+
+type APIV1MarketParserSearchGetGatewayTimeout ErrorResponse
+
+func (*APIV1MarketParserSearchGetGatewayTimeout) aPIV1MarketParserSearchGetRes() {}
+
+type APIV1MarketParserSearchGetInternalServerError ErrorResponse
+
+func (*APIV1MarketParserSearchGetInternalServerError) aPIV1MarketParserSearchGetRes() {}
+
+type APIV1MarketParserSearchGetMode string
+
+const (
+	APIV1MarketParserSearchGetModeBrowser APIV1MarketParserSearchGetMode = "browser"
+	APIV1MarketParserSearchGetModeAPI     APIV1MarketParserSearchGetMode = "api"
+)
+
+// AllValues returns all APIV1MarketParserSearchGetMode values.
+func (APIV1MarketParserSearchGetMode) AllValues() []APIV1MarketParserSearchGetMode {
+	return []APIV1MarketParserSearchGetMode{
+		APIV1MarketParserSearchGetModeBrowser,
+		APIV1MarketParserSearchGetModeAPI,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s APIV1MarketParserSearchGetMode) MarshalText() ([]byte, error) {
+	switch s {
+	case APIV1MarketParserSearchGetModeBrowser:
+		return []byte(s), nil
+	case APIV1MarketParserSearchGetModeAPI:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *APIV1MarketParserSearchGetMode) UnmarshalText(data []byte) error {
+	switch APIV1MarketParserSearchGetMode(data) {
+	case APIV1MarketParserSearchGetModeBrowser:
+		*s = APIV1MarketParserSearchGetModeBrowser
+		return nil
+	case APIV1MarketParserSearchGetModeAPI:
+		*s = APIV1MarketParserSearchGetModeAPI
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// APIV1MarketParserSearchGetPartialContentHeaders wraps []Product with response headers.
+type APIV1MarketParserSearchGetPartialContentHeaders struct {
+	XFailedPages OptString
+	Response     []Product
+}
+
+// GetXFailedPages returns the value of XFailedPages.
+func (s *APIV1MarketParserSearchGetPartialContentHeaders) GetXFailedPages() OptString {
+	return s.XFailedPages
+}
+
+// GetResponse returns the value of Response.
+func (s *APIV1MarketParserSearchGetPartialContentHeaders) GetResponse() []Product {
+	return s.Response
+}
+
+// SetXFailedPages sets the value of XFailedPages.
+func (s *APIV1MarketParserSearchGetPartialContentHeaders) SetXFailedPages(val OptString) {
+	s.XFailedPages = val
+}
+
+// SetResponse sets the value of Response.
+func (s *APIV1MarketParserSearchGetPartialContentHeaders) SetResponse(val []Product) {
+	s.Response = val
+}
+
+func (*APIV1MarketParserSearchGetPartialContentHeaders) aPIV1MarketParserSearchGetRes() {}
+
+type APIV1MarketParserSearchGetSort string
+
+const (
+	APIV1MarketParserSearchGetSortRelevance APIV1MarketParserSearchGetSort = "relevance"
+	APIV1MarketParserSearchGetSortPriceAsc  APIV1MarketParserSearchGetSort = "price_asc"
+	APIV1MarketParserSearchGetSortPriceDesc APIV1MarketParserSearchGetSort = "price_desc"
+	APIV1MarketParserSearchGetSortDiscount  APIV1MarketParserSearchGetSort = "discount"
+)
+
+// AllValues returns all APIV1MarketParserSearchGetSort values.
+func (APIV1MarketParserSearchGetSort) AllValues() []APIV1MarketParserSearchGetSort {
+	return []APIV1MarketParserSearchGetSort{
+		APIV1MarketParserSearchGetSortRelevance,
+		APIV1MarketParserSearchGetSortPriceAsc,
+		APIV1MarketParserSearchGetSortPriceDesc,
+		APIV1MarketParserSearchGetSortDiscount,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s APIV1MarketParserSearchGetSort) MarshalText() ([]byte, error) {
+	switch s {
+	case APIV1MarketParserSearchGetSortRelevance:
+		return []byte(s), nil
+	case APIV1MarketParserSearchGetSortPriceAsc:
+		return []byte(s), nil
+	case APIV1MarketParserSearchGetSortPriceDesc:
+		return []byte(s), nil
+	case APIV1MarketParserSearchGetSortDiscount:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *APIV1MarketParserSearchGetSort) UnmarshalText(data []byte) error {
+	switch APIV1MarketParserSearchGetSort(data) {
+	case APIV1MarketParserSearchGetSortRelevance:
+		*s = APIV1MarketParserSearchGetSortRelevance
+		return nil
+	case APIV1MarketParserSearchGetSortPriceAsc:
+		*s = APIV1MarketParserSearchGetSortPriceAsc
+		return nil
+	case APIV1MarketParserSearchGetSortPriceDesc:
+		*s = APIV1MarketParserSearchGetSortPriceDesc
+		return nil
+	case APIV1MarketParserSearchGetSortDiscount:
+		*s = APIV1MarketParserSearchGetSortDiscount
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type APIV1ProductsIDHistoryGetBadRequest ErrorResponse
+
+func (*APIV1ProductsIDHistoryGetBadRequest) aPIV1ProductsIDHistoryGetRes() {}
+
+type APIV1ProductsIDHistoryGetInternalServerError ErrorResponse
+
+func (*APIV1ProductsIDHistoryGetInternalServerError) aPIV1ProductsIDHistoryGetRes() {}
+
+type APIV1ProductsIDHistoryGetNotFound ErrorResponse
+
+func (*APIV1ProductsIDHistoryGetNotFound) aPIV1ProductsIDHistoryGetRes() {}
+
+type APIV1SchedulesNameRunsGetBadRequest ErrorResponse
+
+func (*APIV1SchedulesNameRunsGetBadRequest) aPIV1SchedulesNameRunsGetRes() {}
+
+type APIV1SchedulesNameRunsGetInternalServerError ErrorResponse
+
+func (*APIV1SchedulesNameRunsGetInternalServerError) aPIV1SchedulesNameRunsGetRes() {}
+
+type APIV1SchedulesNameRunsGetNotFound ErrorResponse
+
+func (*APIV1SchedulesNameRunsGetNotFound) aPIV1SchedulesNameRunsGetRes() {}
+
+type APIV1SnapshotsGetBadRequest ErrorResponse
+
+func (*APIV1SnapshotsGetBadRequest) aPIV1SnapshotsGetRes() {}
+
+type APIV1SnapshotsGetInternalServerError ErrorResponse
+
+func (*APIV1SnapshotsGetInternalServerError) aPIV1SnapshotsGetRes() {}
+
+type APIV1SnapshotsIDGetInternalServerError ErrorResponse
+
+func (*APIV1SnapshotsIDGetInternalServerError) aPIV1SnapshotsIDGetRes() {}
+
+type APIV1SnapshotsIDGetNotFound ErrorResponse
+
+func (*APIV1SnapshotsIDGetNotFound) aPIV1SnapshotsIDGetRes() {}
+
+type APIV1WebhooksDeliveriesGetBadRequest ErrorResponse
+
+func (*APIV1WebhooksDeliveriesGetBadRequest) aPIV1WebhooksDeliveriesGetRes() {}
+
+type APIV1WebhooksDeliveriesGetInternalServerError ErrorResponse
+
+func (*APIV1WebhooksDeliveriesGetInternalServerError) aPIV1WebhooksDeliveriesGetRes() {}
+
+type APIV1WebhooksDeliveriesGetStatus string
+
+const (
+	APIV1WebhooksDeliveriesGetStatusPending   APIV1WebhooksDeliveriesGetStatus = "pending"
+	APIV1WebhooksDeliveriesGetStatusDelivered APIV1WebhooksDeliveriesGetStatus = "delivered"
+	APIV1WebhooksDeliveriesGetStatusFailed    APIV1WebhooksDeliveriesGetStatus = "failed"
+)
+
+// AllValues returns all APIV1WebhooksDeliveriesGetStatus values.
+func (APIV1WebhooksDeliveriesGetStatus) AllValues() []APIV1WebhooksDeliveriesGetStatus {
+	return []APIV1WebhooksDeliveriesGetStatus{
+		APIV1WebhooksDeliveriesGetStatusPending,
+		APIV1WebhooksDeliveriesGetStatusDelivered,
+		APIV1WebhooksDeliveriesGetStatusFailed,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s APIV1WebhooksDeliveriesGetStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case APIV1WebhooksDeliveriesGetStatusPending:
+		return []byte(s), nil
+	case APIV1WebhooksDeliveriesGetStatusDelivered:
+		return []byte(s), nil
+	case APIV1WebhooksDeliveriesGetStatusFailed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *APIV1WebhooksDeliveriesGetStatus) UnmarshalText(data []byte) error {
+	switch APIV1WebhooksDeliveriesGetStatus(data) {
+	case APIV1WebhooksDeliveriesGetStatusPending:
+		*s = APIV1WebhooksDeliveriesGetStatusPending
+		return nil
+	case APIV1WebhooksDeliveriesGetStatusDelivered:
+		*s = APIV1WebhooksDeliveriesGetStatusDelivered
+		return nil
+	case APIV1WebhooksDeliveriesGetStatusFailed:
+		*s = APIV1WebhooksDeliveriesGetStatusFailed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type APIV1WebhooksDeliveriesIDGetInternalServerError ErrorResponse
+
+func (*APIV1WebhooksDeliveriesIDGetInternalServerError) aPIV1WebhooksDeliveriesIDGetRes() {}
+
+type APIV1WebhooksDeliveriesIDGetNotFound ErrorResponse
+
+func (*APIV1WebhooksDeliveriesIDGetNotFound) aPIV1WebhooksDeliveriesIDGetRes() {}
+
+// Ref: #/components/schemas/AlertRule
+type AlertRule struct {
+	ID        string            `json:"id"`
+	Name      string            `json:"name"`
+	Kind      AlertRuleKind     `json:"kind"`
+	Threshold float64           `json:"threshold"`
+	Market    OptString         `json:"market"`
+	Address   OptString         `json:"address"`
+	Category  OptString         `json:"category"`
+	ProductID OptString         `json:"product_id"`
+	Notifier  AlertRuleNotifier `json:"notifier"`
+	Target    OptString         `json:"target"`
+	Enabled   bool              `json:"enabled"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *AlertRule) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *AlertRule) GetName() string {
+	return s.Name
+}
+
+// GetKind returns the value of Kind.
+func (s *AlertRule) GetKind() AlertRuleKind {
+	return s.Kind
+}
+
+// GetThreshold returns the value of Threshold.
+func (s *AlertRule) GetThreshold() float64 {
+	return s.Threshold
+}
+
+// GetMarket returns the value of Market.
+func (s *AlertRule) GetMarket() OptString {
+	return s.Market
+}
+
+// GetAddress returns the value of Address.
+func (s *AlertRule) GetAddress() OptString {
+	return s.Address
+}
+
+// GetCategory returns the value of Category.
+func (s *AlertRule) GetCategory() OptString {
+	return s.Category
+}
+
+// GetProductID returns the value of ProductID.
+func (s *AlertRule) GetProductID() OptString {
+	return s.ProductID
+}
+
+// GetNotifier returns the value of Notifier.
+func (s *AlertRule) GetNotifier() AlertRuleNotifier {
+	return s.Notifier
+}
+
+// GetTarget returns the value of Target.
+func (s *AlertRule) GetTarget() OptString {
+	return s.Target
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *AlertRule) GetEnabled() bool {
+	return s.Enabled
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *AlertRule) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *AlertRule) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *AlertRule) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *AlertRule) SetName(val string) {
+	s.Name = val
+}
+
+// SetKind sets the value of Kind.
+func (s *AlertRule) SetKind(val AlertRuleKind) {
+	s.Kind = val
+}
+
+// SetThreshold sets the value of Threshold.
+func (s *AlertRule) SetThreshold(val float64) {
+	s.Threshold = val
+}
+
+// SetMarket sets the value of Market.
+func (s *AlertRule) SetMarket(val OptString) {
+	s.Market = val
+}
+
+// SetAddress sets the value of Address.
+func (s *AlertRule) SetAddress(val OptString) {
+	s.Address = val
+}
+
+// SetCategory sets the value of Category.
+func (s *AlertRule) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetProductID sets the value of ProductID.
+func (s *AlertRule) SetProductID(val OptString) {
+	s.ProductID = val
+}
+
+// SetNotifier sets the value of Notifier.
+func (s *AlertRule) SetNotifier(val AlertRuleNotifier) {
+	s.Notifier = val
+}
+
+// SetTarget sets the value of Target.
+func (s *AlertRule) SetTarget(val OptString) {
+	s.Target = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *AlertRule) SetEnabled(val bool) {
+	s.Enabled = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *AlertRule) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *AlertRule) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*AlertRule) aPIV1AlertsIDGetRes() {}
+func (*AlertRule) aPIV1AlertsIDPutRes() {}
+func (*AlertRule) aPIV1AlertsPostRes()  {}
+
+type AlertRuleKind string
+
+const (
+	AlertRuleKindPriceDrop  AlertRuleKind = "price_drop"
+	AlertRuleKindPriceRise  AlertRuleKind = "price_rise"
+	AlertRuleKindPriceBelow AlertRuleKind = "price_below"
+)
+
+// AllValues returns all AlertRuleKind values.
+func (AlertRuleKind) AllValues() []AlertRuleKind {
+	return []AlertRuleKind{
+		AlertRuleKindPriceDrop,
+		AlertRuleKindPriceRise,
+		AlertRuleKindPriceBelow,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AlertRuleKind) MarshalText() ([]byte, error) {
+	switch s {
+	case AlertRuleKindPriceDrop:
+		return []byte(s), nil
+	case AlertRuleKindPriceRise:
+		return []byte(s), nil
+	case AlertRuleKindPriceBelow:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AlertRuleKind) UnmarshalText(data []byte) error {
+	switch AlertRuleKind(data) {
+	case AlertRuleKindPriceDrop:
+		*s = AlertRuleKindPriceDrop
+		return nil
+	case AlertRuleKindPriceRise:
+		*s = AlertRuleKindPriceRise
+		return nil
+	case AlertRuleKindPriceBelow:
+		*s = AlertRuleKindPriceBelow
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type AlertRuleNotifier string
+
+const (
+	AlertRuleNotifierWebhook  AlertRuleNotifier = "webhook"
+	AlertRuleNotifierTelegram AlertRuleNotifier = "telegram"
+	AlertRuleNotifierLog      AlertRuleNotifier = "log"
+)
+
+// AllValues returns all AlertRuleNotifier values.
+func (AlertRuleNotifier) AllValues() []AlertRuleNotifier {
+	return []AlertRuleNotifier{
+		AlertRuleNotifierWebhook,
+		AlertRuleNotifierTelegram,
+		AlertRuleNotifierLog,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AlertRuleNotifier) MarshalText() ([]byte, error) {
+	switch s {
+	case AlertRuleNotifierWebhook:
+		return []byte(s), nil
+	case AlertRuleNotifierTelegram:
+		return []byte(s), nil
+	case AlertRuleNotifierLog:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AlertRuleNotifier) UnmarshalText(data []byte) error {
+	switch AlertRuleNotifier(data) {
+	case AlertRuleNotifierWebhook:
+		*s = AlertRuleNotifierWebhook
+		return nil
+	case AlertRuleNotifierTelegram:
+		*s = AlertRuleNotifierTelegram
+		return nil
+	case AlertRuleNotifierLog:
+		*s = AlertRuleNotifierLog
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/AlertRuleRequest
+type AlertRuleRequest struct {
+	Name string `json:"name"`
+	// Price_drop/price_rise compare with the previous snapshot, threshold in percent; price_below fires
+	// when the price is under threshold.
+	Kind      AlertRuleRequestKind `json:"kind"`
+	Threshold float64              `json:"threshold"`
+	// Empty matches any market.
+	Market OptString `json:"market"`
+	// Empty matches any address.
+	Address OptString `json:"address"`
+	// Empty matches any category.
+	Category OptString `json:"category"`
+	// Canonical product URL or SKU. Empty matches any product.
+	ProductID OptString                `json:"product_id"`
+	Notifier  AlertRuleRequestNotifier `json:"notifier"`
+	// Webhook URL or Telegram chat id.
+	Target  OptString `json:"target"`
+	Enabled OptBool   `json:"enabled"`
+}
+
+// GetName returns the value of Name.
+func (s *AlertRuleRequest) GetName() string {
+	return s.Name
+}
+
+// GetKind returns the value of Kind.
+func (s *AlertRuleRequest) GetKind() AlertRuleRequestKind {
+	return s.Kind
+}
+
+// GetThreshold returns the value of Threshold.
+func (s *AlertRuleRequest) GetThreshold() float64 {
+	return s.Threshold
+}
+
+// GetMarket returns the value of Market.
+func (s *AlertRuleRequest) GetMarket() OptString {
+	return s.Market
+}
+
+// GetAddress returns the value of Address.
+func (s *AlertRuleRequest) GetAddress() OptString {
+	return s.Address
+}
+
+// GetCategory returns the value of Category.
+func (s *AlertRuleRequest) GetCategory() OptString {
+	return s.Category
+}
+
+// GetProductID returns the value of ProductID.
+func (s *AlertRuleRequest) GetProductID() OptString {
+	return s.ProductID
+}
+
+// GetNotifier returns the value of Notifier.
+func (s *AlertRuleRequest) GetNotifier() AlertRuleRequestNotifier {
+	return s.Notifier
+}
+
+// GetTarget returns the value of Target.
+func (s *AlertRuleRequest) GetTarget() OptString {
+	return s.Target
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *AlertRuleRequest) GetEnabled() OptBool {
+	return s.Enabled
+}
+
+// SetName sets the value of Name.
+func (s *AlertRuleRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetKind sets the value of Kind.
+func (s *AlertRuleRequest) SetKind(val AlertRuleRequestKind) {
+	s.Kind = val
+}
+
+// SetThreshold sets the value of Threshold.
+func (s *AlertRuleRequest) SetThreshold(val float64) {
+	s.Threshold = val
+}
+
+// SetMarket sets the value of Market.
+func (s *AlertRuleRequest) SetMarket(val OptString) {
+	s.Market = val
+}
+
+// SetAddress sets the value of Address.
+func (s *AlertRuleRequest) SetAddress(val OptString) {
+	s.Address = val
+}
+
+// SetCategory sets the value of Category.
+func (s *AlertRuleRequest) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetProductID sets the value of ProductID.
+func (s *AlertRuleRequest) SetProductID(val OptString) {
+	s.ProductID = val
+}
+
+// SetNotifier sets the value of Notifier.
+func (s *AlertRuleRequest) SetNotifier(val AlertRuleRequestNotifier) {
+	s.Notifier = val
+}
+
+// SetTarget sets the value of Target.
+func (s *AlertRuleRequest) SetTarget(val OptString) {
+	s.Target = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *AlertRuleRequest) SetEnabled(val OptBool) {
+	s.Enabled = val
+}
+
+// Price_drop/price_rise compare with the previous snapshot, threshold in percent; price_below fires
+// when the price is under threshold.
+type AlertRuleRequestKind string
+
+const (
+	AlertRuleRequestKindPriceDrop  AlertRuleRequestKind = "price_drop"
+	AlertRuleRequestKindPriceRise  AlertRuleRequestKind = "price_rise"
+	AlertRuleRequestKindPriceBelow AlertRuleRequestKind = "price_below"
+)
+
+// AllValues returns all AlertRuleRequestKind values.
+func (AlertRuleRequestKind) AllValues() []AlertRuleRequestKind {
+	return []AlertRuleRequestKind{
+		AlertRuleRequestKindPriceDrop,
+		AlertRuleRequestKindPriceRise,
+		AlertRuleRequestKindPriceBelow,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AlertRuleRequestKind) MarshalText() ([]byte, error) {
+	switch s {
+	case AlertRuleRequestKindPriceDrop:
+		return []byte(s), nil
+	case AlertRuleRequestKindPriceRise:
+		return []byte(s), nil
+	case AlertRuleRequestKindPriceBelow:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AlertRuleRequestKind) UnmarshalText(data []byte) error {
+	switch AlertRuleRequestKind(data) {
+	case AlertRuleRequestKindPriceDrop:
+		*s = AlertRuleRequestKindPriceDrop
+		return nil
+	case AlertRuleRequestKindPriceRise:
+		*s = AlertRuleRequestKindPriceRise
+		return nil
+	case AlertRuleRequestKindPriceBelow:
+		*s = AlertRuleRequestKindPriceBelow
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type AlertRuleRequestNotifier string
+
+const (
+	AlertRuleRequestNotifierWebhook  AlertRuleRequestNotifier = "webhook"
+	AlertRuleRequestNotifierTelegram AlertRuleRequestNotifier = "telegram"
+	AlertRuleRequestNotifierLog      AlertRuleRequestNotifier = "log"
+)
+
+// AllValues returns all AlertRuleRequestNotifier values.
+func (AlertRuleRequestNotifier) AllValues() []AlertRuleRequestNotifier {
+	return []AlertRuleRequestNotifier{
+		AlertRuleRequestNotifierWebhook,
+		AlertRuleRequestNotifierTelegram,
+		AlertRuleRequestNotifierLog,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AlertRuleRequestNotifier) MarshalText() ([]byte, error) {
+	switch s {
+	case AlertRuleRequestNotifierWebhook:
+		return []byte(s), nil
+	case AlertRuleRequestNotifierTelegram:
+		return []byte(s), nil
+	case AlertRuleRequestNotifierLog:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AlertRuleRequestNotifier) UnmarshalText(data []byte) error {
+	switch AlertRuleRequestNotifier(data) {
+	case AlertRuleRequestNotifierWebhook:
+		*s = AlertRuleRequestNotifierWebhook
+		return nil
+	case AlertRuleRequestNotifierTelegram:
+		*s = AlertRuleRequestNotifierTelegram
+		return nil
+	case AlertRuleRequestNotifierLog:
+		*s = AlertRuleRequestNotifierLog
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type AlertRulesResponse []AlertRule
+
+func (*AlertRulesResponse) aPIV1AlertsGetRes() {}
+
+type CategoriesResponse []Category
+
+func (*CategoriesResponse) aPIV1MarketParserCategoriesGetRes() {}
+
+// Ref: #/components/schemas/Category
+type Category struct {
+	ID       int64      `json:"id"`
+	Title    string     `json:"title"`
+	Slug     string     `json:"slug"`
+	URL      string     `json:"url"`
+	Children []Category `json:"children"`
+}
+
+// GetID returns the value of ID.
+func (s *Category) GetID() int64 {
+	return s.ID
+}
+
+// GetTitle returns the value of Title.
+func (s *Category) GetTitle() string {
+	return s.Title
+}
+
+// GetSlug returns the value of Slug.
+func (s *Category) GetSlug() string {
+	return s.Slug
+}
+
+// GetURL returns the value of URL.
+func (s *Category) GetURL() string {
+	return s.URL
+}
+
+// GetChildren returns the value of Children.
+func (s *Category) GetChildren() []Category {
+	return s.Children
+}
+
+// SetID sets the value of ID.
+func (s *Category) SetID(val int64) {
+	s.ID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *Category) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetSlug sets the value of Slug.
+func (s *Category) SetSlug(val string) {
+	s.Slug = val
+}
+
+// SetURL sets the value of URL.
+func (s *Category) SetURL(val string) {
+	s.URL = val
+}
+
+// SetChildren sets the value of Children.
+func (s *Category) SetChildren(val []Category) {
+	s.Children = val
+}
+
+// Ref: #/components/schemas/DiffRequest
+type DiffRequest struct {
+	Old ParseResponse `json:"old"`
+	New ParseResponse `json:"new"`
+}
+
+// GetOld returns the value of Old.
+func (s *DiffRequest) GetOld() ParseResponse {
+	return s.Old
+}
+
+// GetNew returns the value of New.
+func (s *DiffRequest) GetNew() ParseResponse {
+	return s.New
+}
+
+// SetOld sets the value of Old.
+func (s *DiffRequest) SetOld(val ParseResponse) {
+	s.Old = val
+}
+
+// SetNew sets the value of New.
+func (s *DiffRequest) SetNew(val ParseResponse) {
+	s.New = val
+}
+
+// Ref: #/components/schemas/ErrorResponse
+type ErrorResponse struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+}
+
+// GetStatus returns the value of Status.
+func (s *ErrorResponse) GetStatus() int {
+	return s.Status
+}
+
+// GetMessage returns the value of Message.
+func (s *ErrorResponse) GetMessage() string {
+	return s.Message
+}
+
+// SetStatus sets the value of Status.
+func (s *ErrorResponse) SetStatus(val int) {
+	s.Status = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ErrorResponse) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*ErrorResponse) aPIV1AlertsGetRes()    {}
+func (*ErrorResponse) aPIV1SchedulesGetRes() {}
+
+// ErrorResponseHeaders wraps ErrorResponse with response headers.
+type ErrorResponseHeaders struct {
+	RetryAfter OptInt
+	Response   ErrorResponse
+}
+
+// GetRetryAfter returns the value of RetryAfter.
+func (s *ErrorResponseHeaders) GetRetryAfter() OptInt {
+	return s.RetryAfter
+}
+
+// GetResponse returns the value of Response.
+func (s *ErrorResponseHeaders) GetResponse() ErrorResponse {
+	return s.Response
+}
+
+// SetRetryAfter sets the value of RetryAfter.
+func (s *ErrorResponseHeaders) SetRetryAfter(val OptInt) {
+	s.RetryAfter = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ErrorResponseHeaders) SetResponse(val ErrorResponse) {
+	s.Response = val
+}
+
+func (*ErrorResponseHeaders) aPIV1DiagnosticsSelectorsGetRes()     {}
+func (*ErrorResponseHeaders) aPIV1MarketParserCategoriesGetRes()   {}
+func (*ErrorResponseHeaders) aPIV1MarketParserMarketsGetRes()      {}
+func (*ErrorResponseHeaders) aPIV1MarketParserParseGetRes()        {}
+func (*ErrorResponseHeaders) aPIV1MarketParserParseMarketsGetRes() {}
+func (*ErrorResponseHeaders) aPIV1MarketParserSearchGetRes()       {}
+
+// Ref: #/components/schemas/Job
+type Job struct {
+	ID       string        `json:"id"`
+	Status   JobStatus     `json:"status"`
+	Request  JobRequest    `json:"request"`
+	Progress JobProgress   `json:"progress"`
+	Products ParseResponse `json:"products"`
+	// Pages that failed after all retries, products contains the other pages.
+	FailedPages []int            `json:"failed_pages"`
+	Error       OptErrorResponse `json:"error"`
+	CreatedAt   time.Time        `json:"created_at"`
+	StartedAt   OptDateTime      `json:"started_at"`
+	FinishedAt  OptDateTime      `json:"finished_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Job) GetID() string {
+	return s.ID
+}
+
+// GetStatus returns the value of Status.
+func (s *Job) GetStatus() JobStatus {
+	return s.Status
+}
+
+// GetRequest returns the value of Request.
+func (s *Job) GetRequest() JobRequest {
+	return s.Request
+}
+
+// GetProgress returns the value of Progress.
+func (s *Job) GetProgress() JobProgress {
+	return s.Progress
+}
+
+// GetProducts returns the value of Products.
+func (s *Job) GetProducts() ParseResponse {
+	return s.Products
+}
+
+// GetFailedPages returns the value of FailedPages.
+func (s *Job) GetFailedPages() []int {
+	return s.FailedPages
+}
+
+// GetError returns the value of Error.
+func (s *Job) GetError() OptErrorResponse {
+	return s.Error
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Job) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetStartedAt returns the value of StartedAt.
+func (s *Job) GetStartedAt() OptDateTime {
+	return s.StartedAt
+}
+
+// GetFinishedAt returns the value of FinishedAt.
+func (s *Job) GetFinishedAt() OptDateTime {
+	return s.FinishedAt
+}
+
+// SetID sets the value of ID.
+func (s *Job) SetID(val string) {
+	s.ID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Job) SetStatus(val JobStatus) {
+	s.Status = val
+}
+
+// SetRequest sets the value of Request.
+func (s *Job) SetRequest(val JobRequest) {
+	s.Request = val
+}
+
+// SetProgress sets the value of Progress.
+func (s *Job) SetProgress(val JobProgress) {
+	s.Progress = val
+}
+
+// SetProducts sets the value of Products.
+func (s *Job) SetProducts(val ParseResponse) {
+	s.Products = val
+}
+
+// SetFailedPages sets the value of FailedPages.
+func (s *Job) SetFailedPages(val []int) {
+	s.FailedPages = val
+}
+
+// SetError sets the value of Error.
+func (s *Job) SetError(val OptErrorResponse) {
+	s.Error = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Job) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetStartedAt sets the value of StartedAt.
+func (s *Job) SetStartedAt(val OptDateTime) {
+	s.StartedAt = val
+}
+
+// SetFinishedAt sets the value of FinishedAt.
+func (s *Job) SetFinishedAt(val OptDateTime) {
+	s.FinishedAt = val
+}
+
+func (*Job) aPIV1JobsIDDeleteRes() {}
+func (*Job) aPIV1JobsIDGetRes()    {}
+func (*Job) aPIV1JobsPostRes()     {}
+
+// Ref: #/components/schemas/JobProgress
+type JobProgress struct {
+	PagesDone  int `json:"pages_done"`
+	PagesTotal int `json:"pages_total"`
+}
+
+// GetPagesDone returns the value of PagesDone.
+func (s *JobProgress) GetPagesDone() int {
+	return s.PagesDone
+}
+
+// GetPagesTotal returns the value of PagesTotal.
+func (s *JobProgress) GetPagesTotal() int {
+	return s.PagesTotal
+}
+
+// SetPagesDone sets the value of PagesDone.
+func (s *JobProgress) SetPagesDone(val int) {
+	s.PagesDone = val
+}
+
+// SetPagesTotal sets the value of PagesTotal.
+func (s *JobProgress) SetPagesTotal(val int) {
+	s.PagesTotal = val
+}
+
+// Ref: #/components/schemas/JobRequest
+type JobRequest struct {
+	// Aggregator, defaults to providers.default.
+	Provider OptString         `json:"provider"`
+	Category string            `json:"category"`
+	Address  string            `json:"address"`
+	Market   string            `json:"market"`
+	Mode     OptJobRequestMode `json:"mode"`
+	// When set, the finished job is POSTed here. See /api/v1/webhooks/deliveries for the delivery log.
+	CallbackURL OptString `json:"callback_url"`
+}
+
+// GetProvider returns the value of Provider.
+func (s *JobRequest) GetProvider() OptString {
+	return s.Provider
+}
+
+// GetCategory returns the value of Category.
+func (s *JobRequest) GetCategory() string {
+	return s.Category
+}
+
+// GetAddress returns the value of Address.
+func (s *JobRequest) GetAddress() string {
+	return s.Address
+}
+
+// GetMarket returns the value of Market.
+func (s *JobRequest) GetMarket() string {
+	return s.Market
+}
+
+// GetMode returns the value of Mode.
+func (s *JobRequest) GetMode() OptJobRequestMode {
+	return s.Mode
+}
+
+// GetCallbackURL returns the value of CallbackURL.
+func (s *JobRequest) GetCallbackURL() OptString {
+	return s.CallbackURL
+}
+
+// SetProvider sets the value of Provider.
+func (s *JobRequest) SetProvider(val OptString) {
+	s.Provider = val
+}
+
+// SetCategory sets the value of Category.
+func (s *JobRequest) SetCategory(val string) {
+	s.Category = val
+}
+
+// SetAddress sets the value of Address.
+func (s *JobRequest) SetAddress(val string) {
+	s.Address = val
+}
+
+// SetMarket sets the value of Market.
+func (s *JobRequest) SetMarket(val string) {
+	s.Market = val
+}
+
+// SetMode sets the value of Mode.
+func (s *JobRequest) SetMode(val OptJobRequestMode) {
+	s.Mode = val
+}
+
+// SetCallbackURL sets the value of CallbackURL.
+func (s *JobRequest) SetCallbackURL(val OptString) {
+	s.CallbackURL = val
+}
+
+type JobRequestMode string
+
+const (
+	JobRequestModeBrowser JobRequestMode = "browser"
+	JobRequestModeAPI     JobRequestMode = "api"
+)
+
+// AllValues returns all JobRequestMode values.
+func (JobRequestMode) AllValues() []JobRequestMode {
+	return []JobRequestMode{
+		JobRequestModeBrowser,
+		JobRequestModeAPI,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s JobRequestMode) MarshalText() ([]byte, error) {
+	switch s {
+	case JobRequestModeBrowser:
+		return []byte(s), nil
+	case JobRequestModeAPI:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *JobRequestMode) UnmarshalText(data []byte) error {
+	switch JobRequestMode(data) {
+	case JobRequestModeBrowser:
+		*s = JobRequestModeBrowser
+		return nil
+	case JobRequestModeAPI:
+		*s = JobRequestModeAPI
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type JobStatus string
+
+const (
+	JobStatusQueued   JobStatus = "queued"
+	JobStatusRunning  JobStatus = "running"
+	JobStatusDone     JobStatus = "done"
+	JobStatusFailed   JobStatus = "failed"
+	JobStatusCanceled JobStatus = "canceled"
+)
+
+// AllValues returns all JobStatus values.
+func (JobStatus) AllValues() []JobStatus {
+	return []JobStatus{
+		JobStatusQueued,
+		JobStatusRunning,
+		JobStatusDone,
+		JobStatusFailed,
+		JobStatusCanceled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s JobStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case JobStatusQueued:
+		return []byte(s), nil
+	case JobStatusRunning:
+		return []byte(s), nil
+	case JobStatusDone:
+		return []byte(s), nil
+	case JobStatusFailed:
+		return []byte(s), nil
+	case JobStatusCanceled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *JobStatus) UnmarshalText(data []byte) error {
+	switch JobStatus(data) {
+	case JobStatusQueued:
+		*s = JobStatusQueued
+		return nil
+	case JobStatusRunning:
+		*s = JobStatusRunning
+		return nil
+	case JobStatusDone:
+		*s = JobStatusDone
+		return nil
+	case JobStatusFailed:
+		*s = JobStatusFailed
+		return nil
+	case JobStatusCanceled:
+		*s = JobStatusCanceled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Market
+type Market struct {
+	Slug         string    `json:"slug"`
+	Name         string    `json:"name"`
+	LogoURL      OptString `json:"logo_url"`
+	DeliveryInfo OptString `json:"delivery_info"`
+}
+
+// GetSlug returns the value of Slug.
+func (s *Market) GetSlug() string {
+	return s.Slug
+}
+
+// GetName returns the value of Name.
+func (s *Market) GetName() string {
+	return s.Name
+}
+
+// GetLogoURL returns the value of LogoURL.
+func (s *Market) GetLogoURL() OptString {
+	return s.LogoURL
+}
+
+// GetDeliveryInfo returns the value of DeliveryInfo.
+func (s *Market) GetDeliveryInfo() OptString {
+	return s.DeliveryInfo
+}
+
+// SetSlug sets the value of Slug.
+func (s *Market) SetSlug(val string) {
+	s.Slug = val
+}
+
+// SetName sets the value of Name.
+func (s *Market) SetName(val string) {
+	s.Name = val
+}
+
+// SetLogoURL sets the value of LogoURL.
+func (s *Market) SetLogoURL(val OptString) {
+	s.LogoURL = val
+}
+
+// SetDeliveryInfo sets the value of DeliveryInfo.
+func (s *Market) SetDeliveryInfo(val OptString) {
+	s.DeliveryInfo = val
+}
+
+// Ref: #/components/schemas/MarketResult
+type MarketResult struct {
+	Market   string        `json:"market"`
+	Products ParseResponse `json:"products"`
+	// Pages that failed after all retries, products contains the other pages.
+	FailedPages []int            `json:"failed_pages"`
+	Error       OptErrorResponse `json:"error"`
+}
+
+// GetMarket returns the value of Market.
+func (s *MarketResult) GetMarket() string {
+	return s.Market
+}
+
+// GetProducts returns the value of Products.
+func (s *MarketResult) GetProducts() ParseResponse {
+	return s.Products
+}
+
+// GetFailedPages returns the value of FailedPages.
+func (s *MarketResult) GetFailedPages() []int {
+	return s.FailedPages
+}
+
+// GetError returns the value of Error.
+func (s *MarketResult) GetError() OptErrorResponse {
+	return s.Error
+}
+
+// SetMarket sets the value of Market.
+func (s *MarketResult) SetMarket(val string) {
+	s.Market = val
+}
+
+// SetProducts sets the value of Products.
+func (s *MarketResult) SetProducts(val ParseResponse) {
+	s.Products = val
+}
+
+// SetFailedPages sets the value of FailedPages.
+func (s *MarketResult) SetFailedPages(val []int) {
+	s.FailedPages = val
+}
+
+// SetError sets the value of Error.
+func (s *MarketResult) SetError(val OptErrorResponse) {
+	s.Error = val
+}
+
+type MarketsParseResponse []MarketResult
+
+func (*MarketsParseResponse) aPIV1MarketParserParseMarketsGetRes() {}
+
+type MarketsResponse []Market
+
+func (*MarketsResponse) aPIV1MarketParserMarketsGetRes() {}
+
+// NewOptAPIV1MarketParserParseGetFormat returns new OptAPIV1MarketParserParseGetFormat with value set to v.
+func NewOptAPIV1MarketParserParseGetFormat(v APIV1MarketParserParseGetFormat) OptAPIV1MarketParserParseGetFormat {
+	return OptAPIV1MarketParserParseGetFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAPIV1MarketParserParseGetFormat is optional APIV1MarketParserParseGetFormat.
+type OptAPIV1MarketParserParseGetFormat struct {
+	Value APIV1MarketParserParseGetFormat
+	Set   bool
+}
+
+// IsSet returns true if OptAPIV1MarketParserParseGetFormat was set.
+func (o OptAPIV1MarketParserParseGetFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAPIV1MarketParserParseGetFormat) Reset() {
+	var v APIV1MarketParserParseGetFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAPIV1MarketParserParseGetFormat) SetTo(v APIV1MarketParserParseGetFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAPIV1MarketParserParseGetFormat) Get() (v APIV1MarketParserParseGetFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAPIV1MarketParserParseGetFormat) Or(d APIV1MarketParserParseGetFormat) APIV1MarketParserParseGetFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAPIV1MarketParserParseGetMode returns new OptAPIV1MarketParserParseGetMode with value set to v.
+func NewOptAPIV1MarketParserParseGetMode(v APIV1MarketParserParseGetMode) OptAPIV1MarketParserParseGetMode {
+	return OptAPIV1MarketParserParseGetMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAPIV1MarketParserParseGetMode is optional APIV1MarketParserParseGetMode.
+type OptAPIV1MarketParserParseGetMode struct {
+	Value APIV1MarketParserParseGetMode
+	Set   bool
+}
+
+// IsSet returns true if OptAPIV1MarketParserParseGetMode was set.
+func (o OptAPIV1MarketParserParseGetMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAPIV1MarketParserParseGetMode) Reset() {
+	var v APIV1MarketParserParseGetMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAPIV1MarketParserParseGetMode) SetTo(v APIV1MarketParserParseGetMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAPIV1MarketParserParseGetMode) Get() (v APIV1MarketParserParseGetMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAPIV1MarketParserParseGetMode) Or(d APIV1MarketParserParseGetMode) APIV1MarketParserParseGetMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAPIV1MarketParserParseMarketsGetMode returns new OptAPIV1MarketParserParseMarketsGetMode with value set to v.
+func NewOptAPIV1MarketParserParseMarketsGetMode(v APIV1MarketParserParseMarketsGetMode) OptAPIV1MarketParserParseMarketsGetMode {
+	return OptAPIV1MarketParserParseMarketsGetMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAPIV1MarketParserParseMarketsGetMode is optional APIV1MarketParserParseMarketsGetMode.
+type OptAPIV1MarketParserParseMarketsGetMode struct {
+	Value APIV1MarketParserParseMarketsGetMode
+	Set   bool
+}
+
+// IsSet returns true if OptAPIV1MarketParserParseMarketsGetMode was set.
+func (o OptAPIV1MarketParserParseMarketsGetMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAPIV1MarketParserParseMarketsGetMode) Reset() {
+	var v APIV1MarketParserParseMarketsGetMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAPIV1MarketParserParseMarketsGetMode) SetTo(v APIV1MarketParserParseMarketsGetMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAPIV1MarketParserParseMarketsGetMode) Get() (v APIV1MarketParserParseMarketsGetMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAPIV1MarketParserParseMarketsGetMode) Or(d APIV1MarketParserParseMarketsGetMode) APIV1MarketParserParseMarketsGetMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAPIV1MarketParserSearchGetMode returns new OptAPIV1MarketParserSearchGetMode with value set to v.
+func NewOptAPIV1MarketParserSearchGetMode(v APIV1MarketParserSearchGetMode) OptAPIV1MarketParserSearchGetMode {
+	return OptAPIV1MarketParserSearchGetMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAPIV1MarketParserSearchGetMode is optional APIV1MarketParserSearchGetMode.
+type OptAPIV1MarketParserSearchGetMode struct {
+	Value APIV1MarketParserSearchGetMode
+	Set   bool
+}
+
+// IsSet returns true if OptAPIV1MarketParserSearchGetMode was set.
+func (o OptAPIV1MarketParserSearchGetMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAPIV1MarketParserSearchGetMode) Reset() {
+	var v APIV1MarketParserSearchGetMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAPIV1MarketParserSearchGetMode) SetTo(v APIV1MarketParserSearchGetMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAPIV1MarketParserSearchGetMode) Get() (v APIV1MarketParserSearchGetMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAPIV1MarketParserSearchGetMode) Or(d APIV1MarketParserSearchGetMode) APIV1MarketParserSearchGetMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAPIV1MarketParserSearchGetSort returns new OptAPIV1MarketParserSearchGetSort with value set to v.
+func NewOptAPIV1MarketParserSearchGetSort(v APIV1MarketParserSearchGetSort) OptAPIV1MarketParserSearchGetSort {
+	return OptAPIV1MarketParserSearchGetSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAPIV1MarketParserSearchGetSort is optional APIV1MarketParserSearchGetSort.
+type OptAPIV1MarketParserSearchGetSort struct {
+	Value APIV1MarketParserSearchGetSort
+	Set   bool
+}
+
+// IsSet returns true if OptAPIV1MarketParserSearchGetSort was set.
+func (o OptAPIV1MarketParserSearchGetSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAPIV1MarketParserSearchGetSort) Reset() {
+	var v APIV1MarketParserSearchGetSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAPIV1MarketParserSearchGetSort) SetTo(v APIV1MarketParserSearchGetSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAPIV1MarketParserSearchGetSort) Get() (v APIV1MarketParserSearchGetSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAPIV1MarketParserSearchGetSort) Or(d APIV1MarketParserSearchGetSort) APIV1MarketParserSearchGetSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAPIV1WebhooksDeliveriesGetStatus returns new OptAPIV1WebhooksDeliveriesGetStatus with value set to v.
+func NewOptAPIV1WebhooksDeliveriesGetStatus(v APIV1WebhooksDeliveriesGetStatus) OptAPIV1WebhooksDeliveriesGetStatus {
+	return OptAPIV1WebhooksDeliveriesGetStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAPIV1WebhooksDeliveriesGetStatus is optional APIV1WebhooksDeliveriesGetStatus.
+type OptAPIV1WebhooksDeliveriesGetStatus struct {
+	Value APIV1WebhooksDeliveriesGetStatus
+	Set   bool
+}
+
+// IsSet returns true if OptAPIV1WebhooksDeliveriesGetStatus was set.
+func (o OptAPIV1WebhooksDeliveriesGetStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAPIV1WebhooksDeliveriesGetStatus) Reset() {
+	var v APIV1WebhooksDeliveriesGetStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAPIV1WebhooksDeliveriesGetStatus) SetTo(v APIV1WebhooksDeliveriesGetStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAPIV1WebhooksDeliveriesGetStatus) Get() (v APIV1WebhooksDeliveriesGetStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAPIV1WebhooksDeliveriesGetStatus) Or(d APIV1WebhooksDeliveriesGetStatus) APIV1WebhooksDeliveriesGetStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptErrorResponse returns new OptErrorResponse with value set to v.
+func NewOptErrorResponse(v ErrorResponse) OptErrorResponse {
+	return OptErrorResponse{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptErrorResponse is optional ErrorResponse.
+type OptErrorResponse struct {
+	Value ErrorResponse
+	Set   bool
+}
+
+// IsSet returns true if OptErrorResponse was set.
+func (o OptErrorResponse) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptErrorResponse) Reset() {
+	var v ErrorResponse
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptErrorResponse) SetTo(v ErrorResponse) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptErrorResponse) Get() (v ErrorResponse, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptErrorResponse) Or(d ErrorResponse) ErrorResponse {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptJobRequestMode returns new OptJobRequestMode with value set to v.
+func NewOptJobRequestMode(v JobRequestMode) OptJobRequestMode {
+	return OptJobRequestMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptJobRequestMode is optional JobRequestMode.
+type OptJobRequestMode struct {
+	Value JobRequestMode
+	Set   bool
+}
+
+// IsSet returns true if OptJobRequestMode was set.
+func (o OptJobRequestMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptJobRequestMode) Reset() {
+	var v JobRequestMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptJobRequestMode) SetTo(v JobRequestMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptJobRequestMode) Get() (v JobRequestMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptJobRequestMode) Or(d JobRequestMode) JobRequestMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptScheduleRun returns new OptScheduleRun with value set to v.
+func NewOptScheduleRun(v ScheduleRun) OptScheduleRun {
+	return OptScheduleRun{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptScheduleRun is optional ScheduleRun.
+type OptScheduleRun struct {
+	Value ScheduleRun
+	Set   bool
+}
+
+// IsSet returns true if OptScheduleRun was set.
+func (o OptScheduleRun) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptScheduleRun) Reset() {
+	var v ScheduleRun
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptScheduleRun) SetTo(v ScheduleRun) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptScheduleRun) Get() (v ScheduleRun, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptScheduleRun) Or(d ScheduleRun) ScheduleRun {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSnapshot returns new OptSnapshot with value set to v.
+func NewOptSnapshot(v Snapshot) OptSnapshot {
+	return OptSnapshot{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSnapshot is optional Snapshot.
+type OptSnapshot struct {
+	Value Snapshot
+	Set   bool
+}
+
+// IsSet returns true if OptSnapshot was set.
+func (o OptSnapshot) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSnapshot) Reset() {
+	var v Snapshot
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSnapshot) SetTo(v Snapshot) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSnapshot) Get() (v Snapshot, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSnapshot) Or(d Snapshot) Snapshot {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+type ParseResponse []Product
+
+func (*ParseResponse) aPIV1MarketParserParseGetRes()  {}
+func (*ParseResponse) aPIV1MarketParserSearchGetRes() {}
+
+// ParseResponseHeaders wraps ParseResponse with response headers.
+type ParseResponseHeaders struct {
+	XFailedPages OptString
+	Response     ParseResponse
+}
+
+// GetXFailedPages returns the value of XFailedPages.
+func (s *ParseResponseHeaders) GetXFailedPages() OptString {
+	return s.XFailedPages
+}
+
+// GetResponse returns the value of Response.
+func (s *ParseResponseHeaders) GetResponse() ParseResponse {
+	return s.Response
+}
+
+// SetXFailedPages sets the value of XFailedPages.
+func (s *ParseResponseHeaders) SetXFailedPages(val OptString) {
+	s.XFailedPages = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ParseResponseHeaders) SetResponse(val ParseResponse) {
+	s.Response = val
+}
+
+func (*ParseResponseHeaders) aPIV1MarketParserParseGetRes() {}
+
+// Ref: #/components/schemas/PriceChange
+type PriceChange struct {
+	Product  Product `json:"product"`
+	OldPrice float64 `json:"old_price"`
+	NewPrice float64 `json:"new_price"`
+	Delta    float64 `json:"delta"`
+	// Change relative to old_price, 0 if old_price is 0.
+	DeltaPercent float64 `json:"delta_percent"`
+}
+
+// GetProduct returns the value of Product.
+func (s *PriceChange) GetProduct() Product {
+	return s.Product
+}
+
+// GetOldPrice returns the value of OldPrice.
+func (s *PriceChange) GetOldPrice() float64 {
+	return s.OldPrice
+}
+
+// GetNewPrice returns the value of NewPrice.
+func (s *PriceChange) GetNewPrice() float64 {
+	return s.NewPrice
+}
+
+// GetDelta returns the value of Delta.
+func (s *PriceChange) GetDelta() float64 {
+	return s.Delta
+}
+
+// GetDeltaPercent returns the value of DeltaPercent.
+func (s *PriceChange) GetDeltaPercent() float64 {
+	return s.DeltaPercent
+}
+
+// SetProduct sets the value of Product.
+func (s *PriceChange) SetProduct(val Product) {
+	s.Product = val
+}
+
+// SetOldPrice sets the value of OldPrice.
+func (s *PriceChange) SetOldPrice(val float64) {
+	s.OldPrice = val
+}
+
+// SetNewPrice sets the value of NewPrice.
+func (s *PriceChange) SetNewPrice(val float64) {
+	s.NewPrice = val
+}
+
+// SetDelta sets the value of Delta.
+func (s *PriceChange) SetDelta(val float64) {
+	s.Delta = val
+}
+
+// SetDeltaPercent sets the value of DeltaPercent.
+func (s *PriceChange) SetDeltaPercent(val float64) {
+	s.DeltaPercent = val
+}
+
+// Ref: #/components/schemas/PriceHistory
+type PriceHistory struct {
+	ProductID string       `json:"product_id"`
+	Name      string       `json:"name"`
+	URL       string       `json:"url"`
+	Sku       OptString    `json:"sku"`
+	Provider  string       `json:"provider"`
+	Market    string       `json:"market"`
+	Address   string       `json:"address"`
+	Min       float64      `json:"min"`
+	Max       float64      `json:"max"`
+	Avg       float64      `json:"avg"`
+	Points    []PricePoint `json:"points"`
+}
+
+// GetProductID returns the value of ProductID.
+func (s *PriceHistory) GetProductID() string {
+	return s.ProductID
+}
+
+// GetName returns the value of Name.
+func (s *PriceHistory) GetName() string {
+	return s.Name
+}
+
+// GetURL returns the value of URL.
+func (s *PriceHistory) GetURL() string {
+	return s.URL
+}
+
+// GetSku returns the value of Sku.
+func (s *PriceHistory) GetSku() OptString {
+	return s.Sku
+}
+
+// GetProvider returns the value of Provider.
+func (s *PriceHistory) GetProvider() string {
+	return s.Provider
+}
+
+// GetMarket returns the value of Market.
+func (s *PriceHistory) GetMarket() string {
+	return s.Market
+}
+
+// GetAddress returns the value of Address.
+func (s *PriceHistory) GetAddress() string {
+	return s.Address
+}
+
+// GetMin returns the value of Min.
+func (s *PriceHistory) GetMin() float64 {
+	return s.Min
+}
+
+// GetMax returns the value of Max.
+func (s *PriceHistory) GetMax() float64 {
+	return s.Max
+}
+
+// GetAvg returns the value of Avg.
+func (s *PriceHistory) GetAvg() float64 {
+	return s.Avg
+}
+
+// GetPoints returns the value of Points.
+func (s *PriceHistory) GetPoints() []PricePoint {
+	return s.Points
+}
+
+// SetProductID sets the value of ProductID.
+func (s *PriceHistory) SetProductID(val string) {
+	s.ProductID = val
+}
+
+// SetName sets the value of Name.
+func (s *PriceHistory) SetName(val string) {
+	s.Name = val
+}
+
+// SetURL sets the value of URL.
+func (s *PriceHistory) SetURL(val string) {
+	s.URL = val
+}
+
+// SetSku sets the value of Sku.
+func (s *PriceHistory) SetSku(val OptString) {
+	s.Sku = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *PriceHistory) SetProvider(val string) {
+	s.Provider = val
+}
+
+// SetMarket sets the value of Market.
+func (s *PriceHistory) SetMarket(val string) {
+	s.Market = val
+}
+
+// SetAddress sets the value of Address.
+func (s *PriceHistory) SetAddress(val string) {
+	s.Address = val
+}
+
+// SetMin sets the value of Min.
+func (s *PriceHistory) SetMin(val float64) {
+	s.Min = val
+}
+
+// SetMax sets the value of Max.
+func (s *PriceHistory) SetMax(val float64) {
+	s.Max = val
+}
+
+// SetAvg sets the value of Avg.
+func (s *PriceHistory) SetAvg(val float64) {
+	s.Avg = val
+}
+
+// SetPoints sets the value of Points.
+func (s *PriceHistory) SetPoints(val []PricePoint) {
+	s.Points = val
+}
+
+func (*PriceHistory) aPIV1ProductsIDHistoryGetRes() {}
+
+// Ref: #/components/schemas/PricePoint
+type PricePoint struct {
+	SnapshotID string    `json:"snapshot_id"`
+	At         time.Time `json:"at"`
+	Price      float64   `json:"price"`
+	InStock    OptBool   `json:"in_stock"`
+}
+
+// GetSnapshotID returns the value of SnapshotID.
+func (s *PricePoint) GetSnapshotID() string {
+	return s.SnapshotID
+}
+
+// GetAt returns the value of At.
+func (s *PricePoint) GetAt() time.Time {
+	return s.At
+}
+
+// GetPrice returns the value of Price.
+func (s *PricePoint) GetPrice() float64 {
+	return s.Price
+}
+
+// GetInStock returns the value of InStock.
+func (s *PricePoint) GetInStock() OptBool {
+	return s.InStock
+}
+
+// SetSnapshotID sets the value of SnapshotID.
+func (s *PricePoint) SetSnapshotID(val string) {
+	s.SnapshotID = val
+}
+
+// SetAt sets the value of At.
+func (s *PricePoint) SetAt(val time.Time) {
+	s.At = val
+}
+
+// SetPrice sets the value of Price.
+func (s *PricePoint) SetPrice(val float64) {
+	s.Price = val
+}
+
+// SetInStock sets the value of InStock.
+func (s *PricePoint) SetInStock(val OptBool) {
+	s.InStock = val
+}
+
+// Ref: #/components/schemas/Product
+type Product struct {
+	Name string `json:"name"`
+	Link string `json:"link"`
+	// Current price, discount included.
+	Price float64   `json:"price"`
+	ID    OptString `json:"id"`
+	Sku   OptString `json:"sku"`
+	Brand OptString `json:"brand"`
+	// Pack size in pack_unit.
+	PackSize OptFloat64 `json:"pack_size"`
+	PackUnit OptString  `json:"pack_unit"`
+	// Price before discount.
+	OriginalPrice   OptFloat64 `json:"original_price"`
+	DiscountPercent OptFloat64 `json:"discount_percent"`
+	InStock         OptBool    `json:"in_stock"`
+	// Maximum quantity per order.
+	MaxQuantity OptInt     `json:"max_quantity"`
+	ImageUrls   []string   `json:"image_urls"`
+	Rating      OptFloat64 `json:"rating"`
+}
+
+// GetName returns the value of Name.
+func (s *Product) GetName() string {
+	return s.Name
+}
+
+// GetLink returns the value of Link.
+func (s *Product) GetLink() string {
+	return s.Link
+}
+
+// GetPrice returns the value of Price.
+func (s *Product) GetPrice() float64 {
+	return s.Price
+}
+
+// GetID returns the value of ID.
+func (s *Product) GetID() OptString {
+	return s.ID
+}
+
+// GetSku returns the value of Sku.
+func (s *Product) GetSku() OptString {
+	return s.Sku
+}
+
+// GetBrand returns the value of Brand.
+func (s *Product) GetBrand() OptString {
+	return s.Brand
+}
+
+// GetPackSize returns the value of PackSize.
+func (s *Product) GetPackSize() OptFloat64 {
+	return s.PackSize
+}
+
+// GetPackUnit returns the value of PackUnit.
+func (s *Product) GetPackUnit() OptString {
+	return s.PackUnit
+}
+
+// GetOriginalPrice returns the value of OriginalPrice.
+func (s *Product) GetOriginalPrice() OptFloat64 {
+	return s.OriginalPrice
+}
+
+// GetDiscountPercent returns the value of DiscountPercent.
+func (s *Product) GetDiscountPercent() OptFloat64 {
+	return s.DiscountPercent
+}
+
+// GetInStock returns the value of InStock.
+func (s *Product) GetInStock() OptBool {
+	return s.InStock
+}
+
+// GetMaxQuantity returns the value of MaxQuantity.
+func (s *Product) GetMaxQuantity() OptInt {
+	return s.MaxQuantity
+}
+
+// GetImageUrls returns the value of ImageUrls.
+func (s *Product) GetImageUrls() []string {
+	return s.ImageUrls
+}
+
+// GetRating returns the value of Rating.
+func (s *Product) GetRating() OptFloat64 {
+	return s.Rating
+}
+
+// SetName sets the value of Name.
+func (s *Product) SetName(val string) {
+	s.Name = val
+}
+
+// SetLink sets the value of Link.
+func (s *Product) SetLink(val string) {
+	s.Link = val
+}
+
+// SetPrice sets the value of Price.
+func (s *Product) SetPrice(val float64) {
+	s.Price = val
+}
+
+// SetID sets the value of ID.
+func (s *Product) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetSku sets the value of Sku.
+func (s *Product) SetSku(val OptString) {
+	s.Sku = val
+}
+
+// SetBrand sets the value of Brand.
+func (s *Product) SetBrand(val OptString) {
+	s.Brand = val
+}
+
+// SetPackSize sets the value of PackSize.
+func (s *Product) SetPackSize(val OptFloat64) {
+	s.PackSize = val
+}
+
+// SetPackUnit sets the value of PackUnit.
+func (s *Product) SetPackUnit(val OptString) {
+	s.PackUnit = val
+}
+
+// SetOriginalPrice sets the value of OriginalPrice.
+func (s *Product) SetOriginalPrice(val OptFloat64) {
+	s.OriginalPrice = val
+}
+
+// SetDiscountPercent sets the value of DiscountPercent.
+func (s *Product) SetDiscountPercent(val OptFloat64) {
+	s.DiscountPercent = val
+}
+
+// SetInStock sets the value of InStock.
+func (s *Product) SetInStock(val OptBool) {
+	s.InStock = val
+}
+
+// SetMaxQuantity sets the value of MaxQuantity.
+func (s *Product) SetMaxQuantity(val OptInt) {
+	s.MaxQuantity = val
+}
+
+// SetImageUrls sets the value of ImageUrls.
+func (s *Product) SetImageUrls(val []string) {
+	s.ImageUrls = val
+}
+
+// SetRating sets the value of Rating.
+func (s *Product) SetRating(val OptFloat64) {
+	s.Rating = val
+}
+
+// Ref: #/components/schemas/ScheduleRun
+type ScheduleRun struct {
+	ID         string                 `json:"id"`
+	Schedule   string                 `json:"schedule"`
+	Status     ScheduleRunStatus      `json:"status"`
+	Targets    []ScheduleTargetResult `json:"targets"`
+	StartedAt  time.Time              `json:"started_at"`
+	FinishedAt OptDateTime            `json:"finished_at"`
+}
+
+// GetID returns the value of ID.
+func (s *ScheduleRun) GetID() string {
+	return s.ID
+}
+
+// GetSchedule returns the value of Schedule.
+func (s *ScheduleRun) GetSchedule() string {
+	return s.Schedule
+}
+
+// GetStatus returns the value of Status.
+func (s *ScheduleRun) GetStatus() ScheduleRunStatus {
+	return s.Status
+}
+
+// GetTargets returns the value of Targets.
+func (s *ScheduleRun) GetTargets() []ScheduleTargetResult {
+	return s.Targets
+}
+
+// GetStartedAt returns the value of StartedAt.
+func (s *ScheduleRun) GetStartedAt() time.Time {
+	return s.StartedAt
+}
+
+// GetFinishedAt returns the value of FinishedAt.
+func (s *ScheduleRun) GetFinishedAt() OptDateTime {
+	return s.FinishedAt
+}
+
+// SetID sets the value of ID.
+func (s *ScheduleRun) SetID(val string) {
+	s.ID = val
+}
+
+// SetSchedule sets the value of Schedule.
+func (s *ScheduleRun) SetSchedule(val string) {
+	s.Schedule = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ScheduleRun) SetStatus(val ScheduleRunStatus) {
+	s.Status = val
+}
+
+// SetTargets sets the value of Targets.
+func (s *ScheduleRun) SetTargets(val []ScheduleTargetResult) {
+	s.Targets = val
+}
+
+// SetStartedAt sets the value of StartedAt.
+func (s *ScheduleRun) SetStartedAt(val time.Time) {
+	s.StartedAt = val
+}
+
+// SetFinishedAt sets the value of FinishedAt.
+func (s *ScheduleRun) SetFinishedAt(val OptDateTime) {
+	s.FinishedAt = val
+}
+
+type ScheduleRunStatus string
+
+const (
+	ScheduleRunStatusRunning ScheduleRunStatus = "running"
+	ScheduleRunStatusSuccess ScheduleRunStatus = "success"
+	ScheduleRunStatusPartial ScheduleRunStatus = "partial"
+	ScheduleRunStatusFailed  ScheduleRunStatus = "failed"
+	ScheduleRunStatusSkipped ScheduleRunStatus = "skipped"
+)
+
+// AllValues returns all ScheduleRunStatus values.
+func (ScheduleRunStatus) AllValues() []ScheduleRunStatus {
+	return []ScheduleRunStatus{
+		ScheduleRunStatusRunning,
+		ScheduleRunStatusSuccess,
+		ScheduleRunStatusPartial,
+		ScheduleRunStatusFailed,
+		ScheduleRunStatusSkipped,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ScheduleRunStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case ScheduleRunStatusRunning:
+		return []byte(s), nil
+	case ScheduleRunStatusSuccess:
+		return []byte(s), nil
+	case ScheduleRunStatusPartial:
+		return []byte(s), nil
+	case ScheduleRunStatusFailed:
+		return []byte(s), nil
+	case ScheduleRunStatusSkipped:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ScheduleRunStatus) UnmarshalText(data []byte) error {
+	switch ScheduleRunStatus(data) {
+	case ScheduleRunStatusRunning:
+		*s = ScheduleRunStatusRunning
+		return nil
+	case ScheduleRunStatusSuccess:
+		*s = ScheduleRunStatusSuccess
+		return nil
+	case ScheduleRunStatusPartial:
+		*s = ScheduleRunStatusPartial
+		return nil
+	case ScheduleRunStatusFailed:
+		*s = ScheduleRunStatusFailed
+		return nil
+	case ScheduleRunStatusSkipped:
+		*s = ScheduleRunStatusSkipped
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ScheduleRunsResponse []ScheduleRun
+
+func (*ScheduleRunsResponse) aPIV1SchedulesNameRunsGetRes() {}
+
+// Ref: #/components/schemas/ScheduleStatus
+type ScheduleStatus struct {
+	Name     string           `json:"name"`
+	Cron     string           `json:"cron"`
+	JitterMs int64            `json:"jitter_ms"`
+	Mode     OptString        `json:"mode"`
+	Targets  []ScheduleTarget `json:"targets"`
+	Running  bool             `json:"running"`
+	NextRun  OptDateTime      `json:"next_run"`
+	LastRun  OptScheduleRun   `json:"last_run"`
+}
+
+// GetName returns the value of Name.
+func (s *ScheduleStatus) GetName() string {
+	return s.Name
+}
+
+// GetCron returns the value of Cron.
+func (s *ScheduleStatus) GetCron() string {
+	return s.Cron
+}
+
+// GetJitterMs returns the value of JitterMs.
+func (s *ScheduleStatus) GetJitterMs() int64 {
+	return s.JitterMs
+}
+
+// GetMode returns the value of Mode.
+func (s *ScheduleStatus) GetMode() OptString {
+	return s.Mode
+}
+
+// GetTargets returns the value of Targets.
+func (s *ScheduleStatus) GetTargets() []ScheduleTarget {
+	return s.Targets
+}
+
+// GetRunning returns the value of Running.
+func (s *ScheduleStatus) GetRunning() bool {
+	return s.Running
+}
+
+// GetNextRun returns the value of NextRun.
+func (s *ScheduleStatus) GetNextRun() OptDateTime {
+	return s.NextRun
+}
+
+// GetLastRun returns the value of LastRun.
+func (s *ScheduleStatus) GetLastRun() OptScheduleRun {
+	return s.LastRun
+}
+
+// SetName sets the value of Name.
+func (s *ScheduleStatus) SetName(val string) {
+	s.Name = val
+}
+
+// SetCron sets the value of Cron.
+func (s *ScheduleStatus) SetCron(val string) {
+	s.Cron = val
+}
+
+// SetJitterMs sets the value of JitterMs.
+func (s *ScheduleStatus) SetJitterMs(val int64) {
+	s.JitterMs = val
+}
+
+// SetMode sets the value of Mode.
+func (s *ScheduleStatus) SetMode(val OptString) {
+	s.Mode = val
+}
+
+// SetTargets sets the value of Targets.
+func (s *ScheduleStatus) SetTargets(val []ScheduleTarget) {
+	s.Targets = val
+}
+
+// SetRunning sets the value of Running.
+func (s *ScheduleStatus) SetRunning(val bool) {
+	s.Running = val
+}
+
+// SetNextRun sets the value of NextRun.
+func (s *ScheduleStatus) SetNextRun(val OptDateTime) {
+	s.NextRun = val
+}
+
+// SetLastRun sets the value of LastRun.
+func (s *ScheduleStatus) SetLastRun(val OptScheduleRun) {
+	s.LastRun = val
+}
+
+// Ref: #/components/schemas/ScheduleTarget
+type ScheduleTarget struct {
+	Provider OptString `json:"provider"`
+	Market   string    `json:"market"`
+	Address  string    `json:"address"`
+	Category string    `json:"category"`
+}
+
+// GetProvider returns the value of Provider.
+func (s *ScheduleTarget) GetProvider() OptString {
+	return s.Provider
+}
+
+// GetMarket returns the value of Market.
+func (s *ScheduleTarget) GetMarket() string {
+	return s.Market
+}
+
+// GetAddress returns the value of Address.
+func (s *ScheduleTarget) GetAddress() string {
+	return s.Address
+}
+
+// GetCategory returns the value of Category.
+func (s *ScheduleTarget) GetCategory() string {
+	return s.Category
+}
+
+// SetProvider sets the value of Provider.
+func (s *ScheduleTarget) SetProvider(val OptString) {
+	s.Provider = val
+}
+
+// SetMarket sets the value of Market.
+func (s *ScheduleTarget) SetMarket(val string) {
+	s.Market = val
+}
+
+// SetAddress sets the value of Address.
+func (s *ScheduleTarget) SetAddress(val string) {
+	s.Address = val
+}
+
+// SetCategory sets the value of Category.
+func (s *ScheduleTarget) SetCategory(val string) {
+	s.Category = val
+}
+
+// Ref: #/components/schemas/ScheduleTargetResult
+type ScheduleTargetResult struct {
+	Target     ScheduleTarget `json:"target"`
+	Products   int            `json:"products"`
+	Error      OptString      `json:"error"`
+	DurationMs int64          `json:"duration_ms"`
+}
+
+// GetTarget returns the value of Target.
+func (s *ScheduleTargetResult) GetTarget() ScheduleTarget {
+	return s.Target
+}
+
+// GetProducts returns the value of Products.
+func (s *ScheduleTargetResult) GetProducts() int {
+	return s.Products
+}
+
+// GetError returns the value of Error.
+func (s *ScheduleTargetResult) GetError() OptString {
+	return s.Error
+}
+
+// GetDurationMs returns the value of DurationMs.
+func (s *ScheduleTargetResult) GetDurationMs() int64 {
+	return s.DurationMs
+}
+
+// SetTarget sets the value of Target.
+func (s *ScheduleTargetResult) SetTarget(val ScheduleTarget) {
+	s.Target = val
+}
+
+// SetProducts sets the value of Products.
+func (s *ScheduleTargetResult) SetProducts(val int) {
+	s.Products = val
+}
+
+// SetError sets the value of Error.
+func (s *ScheduleTargetResult) SetError(val OptString) {
+	s.Error = val
+}
+
+// SetDurationMs sets the value of DurationMs.
+func (s *ScheduleTargetResult) SetDurationMs(val int64) {
+	s.DurationMs = val
+}
+
+type SchedulesResponse []ScheduleStatus
+
+func (*SchedulesResponse) aPIV1SchedulesGetRes() {}
+
+// Ref: #/components/schemas/SelectorCheck
+type SelectorCheck struct {
+	// Selector key in the provider config.
+	Name     string            `json:"name"`
+	Selector string            `json:"selector"`
+	Page     SelectorCheckPage `json:"page"`
+	// False for selectors that are not always on the page: captcha, pagination.
+	Required   bool                `json:"required"`
+	Status     SelectorCheckStatus `json:"status"`
+	DurationMs int64               `json:"duration_ms"`
+	Error      OptString           `json:"error"`
+	// Base64 PNG of the page, only for failed required selectors.
+	Screenshot []byte `json:"screenshot"`
+}
+
+// GetName returns the value of Name.
+func (s *SelectorCheck) GetName() string {
+	return s.Name
+}
+
+// GetSelector returns the value of Selector.
+func (s *SelectorCheck) GetSelector() string {
+	return s.Selector
+}
+
+// GetPage returns the value of Page.
+func (s *SelectorCheck) GetPage() SelectorCheckPage {
+	return s.Page
+}
+
+// GetRequired returns the value of Required.
+func (s *SelectorCheck) GetRequired() bool {
+	return s.Required
+}
+
+// GetStatus returns the value of Status.
+func (s *SelectorCheck) GetStatus() SelectorCheckStatus {
+	return s.Status
+}
+
+// GetDurationMs returns the value of DurationMs.
+func (s *SelectorCheck) GetDurationMs() int64 {
+	return s.DurationMs
+}
+
+// GetError returns the value of Error.
+func (s *SelectorCheck) GetError() OptString {
+	return s.Error
+}
+
+// GetScreenshot returns the value of Screenshot.
+func (s *SelectorCheck) GetScreenshot() []byte {
+	return s.Screenshot
+}
+
+// SetName sets the value of Name.
+func (s *SelectorCheck) SetName(val string) {
+	s.Name = val
+}
+
+// SetSelector sets the value of Selector.
+func (s *SelectorCheck) SetSelector(val string) {
+	s.Selector = val
+}
+
+// SetPage sets the value of Page.
+func (s *SelectorCheck) SetPage(val SelectorCheckPage) {
+	s.Page = val
+}
+
+// SetRequired sets the value of Required.
+func (s *SelectorCheck) SetRequired(val bool) {
+	s.Required = val
+}
+
+// SetStatus sets the value of Status.
+func (s *SelectorCheck) SetStatus(val SelectorCheckStatus) {
+	s.Status = val
+}
+
+// SetDurationMs sets the value of DurationMs.
+func (s *SelectorCheck) SetDurationMs(val int64) {
+	s.DurationMs = val
+}
+
+// SetError sets the value of Error.
+func (s *SelectorCheck) SetError(val OptString) {
+	s.Error = val
+}
+
+// SetScreenshot sets the value of Screenshot.
+func (s *SelectorCheck) SetScreenshot(val []byte) {
+	s.Screenshot = val
+}
+
+type SelectorCheckPage string
+
+const (
+	SelectorCheckPageHome     SelectorCheckPage = "home"
+	SelectorCheckPageAddress  SelectorCheckPage = "address"
+	SelectorCheckPageMarket   SelectorCheckPage = "market"
+	SelectorCheckPageCategory SelectorCheckPage = "category"
+	SelectorCheckPageProducts SelectorCheckPage = "products"
+)
+
+// AllValues returns all SelectorCheckPage values.
+func (SelectorCheckPage) AllValues() []SelectorCheckPage {
+	return []SelectorCheckPage{
+		SelectorCheckPageHome,
+		SelectorCheckPageAddress,
+		SelectorCheckPageMarket,
+		SelectorCheckPageCategory,
+		SelectorCheckPageProducts,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SelectorCheckPage) MarshalText() ([]byte, error) {
+	switch s {
+	case SelectorCheckPageHome:
+		return []byte(s), nil
+	case SelectorCheckPageAddress:
+		return []byte(s), nil
+	case SelectorCheckPageMarket:
+		return []byte(s), nil
+	case SelectorCheckPageCategory:
+		return []byte(s), nil
+	case SelectorCheckPageProducts:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SelectorCheckPage) UnmarshalText(data []byte) error {
+	switch SelectorCheckPage(data) {
+	case SelectorCheckPageHome:
+		*s = SelectorCheckPageHome
+		return nil
+	case SelectorCheckPageAddress:
+		*s = SelectorCheckPageAddress
+		return nil
+	case SelectorCheckPageMarket:
+		*s = SelectorCheckPageMarket
+		return nil
+	case SelectorCheckPageCategory:
+		*s = SelectorCheckPageCategory
+		return nil
+	case SelectorCheckPageProducts:
+		*s = SelectorCheckPageProducts
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type SelectorCheckStatus string
+
+const (
+	SelectorCheckStatusFound      SelectorCheckStatus = "found"
+	SelectorCheckStatusMissing    SelectorCheckStatus = "missing"
+	SelectorCheckStatusNotVisible SelectorCheckStatus = "not_visible"
+	SelectorCheckStatusSkipped    SelectorCheckStatus = "skipped"
+)
+
+// AllValues returns all SelectorCheckStatus values.
+func (SelectorCheckStatus) AllValues() []SelectorCheckStatus {
+	return []SelectorCheckStatus{
+		SelectorCheckStatusFound,
+		SelectorCheckStatusMissing,
+		SelectorCheckStatusNotVisible,
+		SelectorCheckStatusSkipped,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SelectorCheckStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case SelectorCheckStatusFound:
+		return []byte(s), nil
+	case SelectorCheckStatusMissing:
+		return []byte(s), nil
+	case SelectorCheckStatusNotVisible:
+		return []byte(s), nil
+	case SelectorCheckStatusSkipped:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SelectorCheckStatus) UnmarshalText(data []byte) error {
+	switch SelectorCheckStatus(data) {
+	case SelectorCheckStatusFound:
+		*s = SelectorCheckStatusFound
+		return nil
+	case SelectorCheckStatusMissing:
+		*s = SelectorCheckStatusMissing
+		return nil
+	case SelectorCheckStatusNotVisible:
+		*s = SelectorCheckStatusNotVisible
+		return nil
+	case SelectorCheckStatusSkipped:
+		*s = SelectorCheckStatusSkipped
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/SelectorReport
+type SelectorReport struct {
+	Provider string    `json:"provider"`
+	Market   string    `json:"market"`
+	Address  string    `json:"address"`
+	Category OptString `json:"category"`
+	// Every required selector was found and visible.
+	Healthy    bool            `json:"healthy"`
+	StartedAt  time.Time       `json:"started_at"`
+	DurationMs int64           `json:"duration_ms"`
+	Checks     []SelectorCheck `json:"checks"`
+}
+
+// GetProvider returns the value of Provider.
+func (s *SelectorReport) GetProvider() string {
+	return s.Provider
+}
+
+// GetMarket returns the value of Market.
+func (s *SelectorReport) GetMarket() string {
+	return s.Market
+}
+
+// GetAddress returns the value of Address.
+func (s *SelectorReport) GetAddress() string {
+	return s.Address
+}
+
+// GetCategory returns the value of Category.
+func (s *SelectorReport) GetCategory() OptString {
+	return s.Category
+}
+
+// GetHealthy returns the value of Healthy.
+func (s *SelectorReport) GetHealthy() bool {
+	return s.Healthy
+}
+
+// GetStartedAt returns the value of StartedAt.
+func (s *SelectorReport) GetStartedAt() time.Time {
+	return s.StartedAt
+}
+
+// GetDurationMs returns the value of DurationMs.
+func (s *SelectorReport) GetDurationMs() int64 {
+	return s.DurationMs
+}
+
+// GetChecks returns the value of Checks.
+func (s *SelectorReport) GetChecks() []SelectorCheck {
+	return s.Checks
+}
+
+// SetProvider sets the value of Provider.
+func (s *SelectorReport) SetProvider(val string) {
+	s.Provider = val
+}
+
+// SetMarket sets the value of Market.
+func (s *SelectorReport) SetMarket(val string) {
+	s.Market = val
+}
+
+// SetAddress sets the value of Address.
+func (s *SelectorReport) SetAddress(val string) {
+	s.Address = val
+}
+
+// SetCategory sets the value of Category.
+func (s *SelectorReport) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetHealthy sets the value of Healthy.
+func (s *SelectorReport) SetHealthy(val bool) {
+	s.Healthy = val
+}
+
+// SetStartedAt sets the value of StartedAt.
+func (s *SelectorReport) SetStartedAt(val time.Time) {
+	s.StartedAt = val
+}
+
+// SetDurationMs sets the value of DurationMs.
+func (s *SelectorReport) SetDurationMs(val int64) {
+	s.DurationMs = val
+}
+
+// SetChecks sets the value of Checks.
+func (s *SelectorReport) SetChecks(val []SelectorCheck) {
+	s.Checks = val
+}
+
+func (*SelectorReport) aPIV1DiagnosticsSelectorsGetRes() {}
+
+// Ref: #/components/schemas/Snapshot
+type Snapshot struct {
+	ID       string `json:"id"`
+	Provider string `json:"provider"`
+	Market   string `json:"market"`
+	Address  string `json:"address"`
+	// Category name, or search:<query> for search results.
+	Category      string         `json:"category"`
+	Source        SnapshotSource `json:"source"`
+	CreatedAt     time.Time      `json:"created_at"`
+	ProductsCount int            `json:"products_count"`
+	Products      ParseResponse  `json:"products"`
+}
+
+// GetID returns the value of ID.
+func (s *Snapshot) GetID() string {
+	return s.ID
+}
+
+// GetProvider returns the value of Provider.
+func (s *Snapshot) GetProvider() string {
+	return s.Provider
+}
+
+// GetMarket returns the value of Market.
+func (s *Snapshot) GetMarket() string {
+	return s.Market
+}
+
+// GetAddress returns the value of Address.
+func (s *Snapshot) GetAddress() string {
+	return s.Address
+}
+
+// GetCategory returns the value of Category.
+func (s *Snapshot) GetCategory() string {
+	return s.Category
+}
+
+// GetSource returns the value of Source.
+func (s *Snapshot) GetSource() SnapshotSource {
+	return s.Source
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Snapshot) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetProductsCount returns the value of ProductsCount.
+func (s *Snapshot) GetProductsCount() int {
+	return s.ProductsCount
+}
+
+// GetProducts returns the value of Products.
+func (s *Snapshot) GetProducts() ParseResponse {
+	return s.Products
+}
+
+// SetID sets the value of ID.
+func (s *Snapshot) SetID(val string) {
+	s.ID = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *Snapshot) SetProvider(val string) {
+	s.Provider = val
+}
+
+// SetMarket sets the value of Market.
+func (s *Snapshot) SetMarket(val string) {
+	s.Market = val
+}
+
+// SetAddress sets the value of Address.
+func (s *Snapshot) SetAddress(val string) {
+	s.Address = val
+}
+
+// SetCategory sets the value of Category.
+func (s *Snapshot) SetCategory(val string) {
+	s.Category = val
+}
+
+// SetSource sets the value of Source.
+func (s *Snapshot) SetSource(val SnapshotSource) {
+	s.Source = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Snapshot) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetProductsCount sets the value of ProductsCount.
+func (s *Snapshot) SetProductsCount(val int) {
+	s.ProductsCount = val
+}
+
+// SetProducts sets the value of Products.
+func (s *Snapshot) SetProducts(val ParseResponse) {
+	s.Products = val
+}
+
+func (*Snapshot) aPIV1SnapshotsIDGetRes() {}
+
+// Ref: #/components/schemas/SnapshotDiff
+type SnapshotDiff struct {
+	From         OptSnapshot   `json:"from"`
+	To           OptSnapshot   `json:"to"`
+	Added        ParseResponse `json:"added"`
+	Removed      ParseResponse `json:"removed"`
+	PriceUp      []PriceChange `json:"price_up"`
+	PriceDown    []PriceChange `json:"price_down"`
+	StockChanged []StockChange `json:"stock_changed"`
+}
+
+// GetFrom returns the value of From.
+func (s *SnapshotDiff) GetFrom() OptSnapshot {
+	return s.From
+}
+
+// GetTo returns the value of To.
+func (s *SnapshotDiff) GetTo() OptSnapshot {
+	return s.To
+}
+
+// GetAdded returns the value of Added.
+func (s *SnapshotDiff) GetAdded() ParseResponse {
+	return s.Added
+}
+
+// GetRemoved returns the value of Removed.
+func (s *SnapshotDiff) GetRemoved() ParseResponse {
+	return s.Removed
+}
+
+// GetPriceUp returns the value of PriceUp.
+func (s *SnapshotDiff) GetPriceUp() []PriceChange {
+	return s.PriceUp
+}
+
+// GetPriceDown returns the value of PriceDown.
+func (s *SnapshotDiff) GetPriceDown() []PriceChange {
+	return s.PriceDown
+}
+
+// GetStockChanged returns the value of StockChanged.
+func (s *SnapshotDiff) GetStockChanged() []StockChange {
+	return s.StockChanged
+}
+
+// SetFrom sets the value of From.
+func (s *SnapshotDiff) SetFrom(val OptSnapshot) {
+	s.From = val
+}
+
+// SetTo sets the value of To.
+func (s *SnapshotDiff) SetTo(val OptSnapshot) {
+	s.To = val
+}
+
+// SetAdded sets the value of Added.
+func (s *SnapshotDiff) SetAdded(val ParseResponse) {
+	s.Added = val
+}
+
+// SetRemoved sets the value of Removed.
+func (s *SnapshotDiff) SetRemoved(val ParseResponse) {
+	s.Removed = val
+}
+
+// SetPriceUp sets the value of PriceUp.
+func (s *SnapshotDiff) SetPriceUp(val []PriceChange) {
+	s.PriceUp = val
+}
+
+// SetPriceDown sets the value of PriceDown.
+func (s *SnapshotDiff) SetPriceDown(val []PriceChange) {
+	s.PriceDown = val
+}
+
+// SetStockChanged sets the value of StockChanged.
+func (s *SnapshotDiff) SetStockChanged(val []StockChange) {
+	s.StockChanged = val
+}
+
+func (*SnapshotDiff) aPIV1DiffGetRes()  {}
+func (*SnapshotDiff) aPIV1DiffPostRes() {}
+
+type SnapshotSource string
+
+const (
+	SnapshotSourceHTTP     SnapshotSource = "http"
+	SnapshotSourceJob      SnapshotSource = "job"
+	SnapshotSourceSchedule SnapshotSource = "schedule"
+)
+
+// AllValues returns all SnapshotSource values.
+func (SnapshotSource) AllValues() []SnapshotSource {
+	return []SnapshotSource{
+		SnapshotSourceHTTP,
+		SnapshotSourceJob,
+		SnapshotSourceSchedule,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SnapshotSource) MarshalText() ([]byte, error) {
+	switch s {
+	case SnapshotSourceHTTP:
+		return []byte(s), nil
+	case SnapshotSourceJob:
+		return []byte(s), nil
+	case SnapshotSourceSchedule:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SnapshotSource) UnmarshalText(data []byte) error {
+	switch SnapshotSource(data) {
+	case SnapshotSourceHTTP:
+		*s = SnapshotSourceHTTP
+		return nil
+	case SnapshotSourceJob:
+		*s = SnapshotSourceJob
+		return nil
+	case SnapshotSourceSchedule:
+		*s = SnapshotSourceSchedule
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type SnapshotsResponse []Snapshot
+
+func (*SnapshotsResponse) aPIV1SnapshotsGetRes() {}
+
+// Ref: #/components/schemas/StockChange
+type StockChange struct {
+	Product    Product `json:"product"`
+	WasInStock bool    `json:"was_in_stock"`
+	InStock    bool    `json:"in_stock"`
+}
+
+// GetProduct returns the value of Product.
+func (s *StockChange) GetProduct() Product {
+	return s.Product
+}
+
+// GetWasInStock returns the value of WasInStock.
+func (s *StockChange) GetWasInStock() bool {
+	return s.WasInStock
+}
+
+// GetInStock returns the value of InStock.
+func (s *StockChange) GetInStock() bool {
+	return s.InStock
+}
+
+// SetProduct sets the value of Product.
+func (s *StockChange) SetProduct(val Product) {
+	s.Product = val
+}
+
+// SetWasInStock sets the value of WasInStock.
+func (s *StockChange) SetWasInStock(val bool) {
+	s.WasInStock = val
+}
+
+// SetInStock sets the value of InStock.
+func (s *StockChange) SetInStock(val bool) {
+	s.InStock = val
+}
+
+// Ref: #/components/schemas/WebhookAttempt
+type WebhookAttempt struct {
+	Attempt int       `json:"attempt"`
+	At      time.Time `json:"at"`
+	// Receiver response code, absent when no response was received.
+	StatusCode OptInt    `json:"status_code"`
+	Error      OptString `json:"error"`
+	DurationMs int64     `json:"duration_ms"`
+}
+
+// GetAttempt returns the value of Attempt.
+func (s *WebhookAttempt) GetAttempt() int {
+	return s.Attempt
+}
+
+// GetAt returns the value of At.
+func (s *WebhookAttempt) GetAt() time.Time {
+	return s.At
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *WebhookAttempt) GetStatusCode() OptInt {
+	return s.StatusCode
+}
+
+// GetError returns the value of Error.
+func (s *WebhookAttempt) GetError() OptString {
+	return s.Error
+}
+
+// GetDurationMs returns the value of DurationMs.
+func (s *WebhookAttempt) GetDurationMs() int64 {
+	return s.DurationMs
+}
+
+// SetAttempt sets the value of Attempt.
+func (s *WebhookAttempt) SetAttempt(val int) {
+	s.Attempt = val
+}
+
+// SetAt sets the value of At.
+func (s *WebhookAttempt) SetAt(val time.Time) {
+	s.At = val
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *WebhookAttempt) SetStatusCode(val OptInt) {
+	s.StatusCode = val
+}
+
+// SetError sets the value of Error.
+func (s *WebhookAttempt) SetError(val OptString) {
+	s.Error = val
+}
+
+// SetDurationMs sets the value of DurationMs.
+func (s *WebhookAttempt) SetDurationMs(val int64) {
+	s.DurationMs = val
+}
+
+type WebhookDeliveriesResponse []WebhookDelivery
+
+func (*WebhookDeliveriesResponse) aPIV1WebhooksDeliveriesGetRes() {}
+
+// Ref: #/components/schemas/WebhookDelivery
+type WebhookDelivery struct {
+	ID          string                `json:"id"`
+	JobID       string                `json:"job_id"`
+	URL         string                `json:"url"`
+	Status      WebhookDeliveryStatus `json:"status"`
+	Attempts    []WebhookAttempt      `json:"attempts"`
+	CreatedAt   time.Time             `json:"created_at"`
+	DeliveredAt OptDateTime           `json:"delivered_at"`
+}
+
+// GetID returns the value of ID.
+func (s *WebhookDelivery) GetID() string {
+	return s.ID
+}
+
+// GetJobID returns the value of JobID.
+func (s *WebhookDelivery) GetJobID() string {
+	return s.JobID
+}
+
+// GetURL returns the value of URL.
+func (s *WebhookDelivery) GetURL() string {
+	return s.URL
+}
+
+// GetStatus returns the value of Status.
+func (s *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	return s.Status
+}
+
+// GetAttempts returns the value of Attempts.
+func (s *WebhookDelivery) GetAttempts() []WebhookAttempt {
+	return s.Attempts
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *WebhookDelivery) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetDeliveredAt returns the value of DeliveredAt.
+func (s *WebhookDelivery) GetDeliveredAt() OptDateTime {
+	return s.DeliveredAt
+}
+
+// SetID sets the value of ID.
+func (s *WebhookDelivery) SetID(val string) {
+	s.ID = val
+}
+
+// SetJobID sets the value of JobID.
+func (s *WebhookDelivery) SetJobID(val string) {
+	s.JobID = val
+}
+
+// SetURL sets the value of URL.
+func (s *WebhookDelivery) SetURL(val string) {
+	s.URL = val
+}
+
+// SetStatus sets the value of Status.
+func (s *WebhookDelivery) SetStatus(val WebhookDeliveryStatus) {
+	s.Status = val
+}
+
+// SetAttempts sets the value of Attempts.
+func (s *WebhookDelivery) SetAttempts(val []WebhookAttempt) {
+	s.Attempts = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *WebhookDelivery) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetDeliveredAt sets the value of DeliveredAt.
+func (s *WebhookDelivery) SetDeliveredAt(val OptDateTime) {
+	s.DeliveredAt = val
+}
+
+func (*WebhookDelivery) aPIV1WebhooksDeliveriesIDGetRes() {}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+// AllValues returns all WebhookDeliveryStatus values.
+func (WebhookDeliveryStatus) AllValues() []WebhookDeliveryStatus {
+	return []WebhookDeliveryStatus{
+		WebhookDeliveryStatusPending,
+		WebhookDeliveryStatusDelivered,
+		WebhookDeliveryStatusFailed,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s WebhookDeliveryStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case WebhookDeliveryStatusPending:
+		return []byte(s), nil
+	case WebhookDeliveryStatusDelivered:
+		return []byte(s), nil
+	case WebhookDeliveryStatusFailed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WebhookDeliveryStatus) UnmarshalText(data []byte) error {
+	switch WebhookDeliveryStatus(data) {
+	case WebhookDeliveryStatusPending:
+		*s = WebhookDeliveryStatusPending
+		return nil
+	case WebhookDeliveryStatusDelivered:
+		*s = WebhookDeliveryStatusDelivered
+		return nil
+	case WebhookDeliveryStatusFailed:
+		*s = WebhookDeliveryStatusFailed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
@@ -8,12 +8,154 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// APIV1AlertsGet implements GET /api/v1/alerts operation.
+	//
+	// Alert rules.
+	//
+	// GET /api/v1/alerts
+	APIV1AlertsGet(ctx context.Context) (APIV1AlertsGetRes, error)
+	// APIV1AlertsIDDelete implements DELETE /api/v1/alerts/{id} operation.
+	//
+	// Delete alert rule.
+	//
+	// DELETE /api/v1/alerts/{id}
+	APIV1AlertsIDDelete(ctx context.Context, params APIV1AlertsIDDeleteParams) (APIV1AlertsIDDeleteRes, error)
+	// APIV1AlertsIDGet implements GET /api/v1/alerts/{id} operation.
+	//
+	// Alert rule.
+	//
+	// GET /api/v1/alerts/{id}
+	APIV1AlertsIDGet(ctx context.Context, params APIV1AlertsIDGetParams) (APIV1AlertsIDGetRes, error)
+	// APIV1AlertsIDPut implements PUT /api/v1/alerts/{id} operation.
+	//
+	// Replace alert rule.
+	//
+	// PUT /api/v1/alerts/{id}
+	APIV1AlertsIDPut(ctx context.Context, req *AlertRuleRequest, params APIV1AlertsIDPutParams) (APIV1AlertsIDPutRes, error)
+	// APIV1AlertsPost implements POST /api/v1/alerts operation.
+	//
+	// The rule is checked against the previous snapshot of the same market, address and category every
+	// time a parse finishes.
+	//
+	// POST /api/v1/alerts
+	APIV1AlertsPost(ctx context.Context, req *AlertRuleRequest) (APIV1AlertsPostRes, error)
+	// APIV1DiagnosticsSelectorsGet implements GET /api/v1/diagnostics/selectors operation.
+	//
+	// Walks the parsing flow of the provider (home page, delivery address, market cards, market page and,
+	//  with category, the category product list) and reports every configured selector as found, missing,
+	//  not_visible or skipped. A PNG screenshot of the page is attached to each failed required selector.
+	//  Intended as a canary before scheduled crawls: check the healthy flag.
+	//
+	// GET /api/v1/diagnostics/selectors
+	APIV1DiagnosticsSelectorsGet(ctx context.Context, params APIV1DiagnosticsSelectorsGetParams) (APIV1DiagnosticsSelectorsGetRes, error)
+	// APIV1DiffGet implements GET /api/v1/diff operation.
+	//
+	// Compares two saved snapshots of the same provider, market, address and category.
+	//
+	// GET /api/v1/diff
+	APIV1DiffGet(ctx context.Context, params APIV1DiffGetParams) (APIV1DiffGetRes, error)
+	// APIV1DiffPost implements POST /api/v1/diff operation.
+	//
+	// Compares two saved /parse responses of the same market, address and category.
+	//
+	// POST /api/v1/diff
+	APIV1DiffPost(ctx context.Context, req *DiffRequest) (APIV1DiffPostRes, error)
+	// APIV1JobsIDDelete implements DELETE /api/v1/jobs/{id} operation.
+	//
+	// Cancels a queued job or interrupts a running one. A finished job is returned unchanged.
+	//
+	// DELETE /api/v1/jobs/{id}
+	APIV1JobsIDDelete(ctx context.Context, params APIV1JobsIDDeleteParams) (APIV1JobsIDDeleteRes, error)
+	// APIV1JobsIDGet implements GET /api/v1/jobs/{id} operation.
+	//
+	// Returns job status, page progress and, once done, the parsed products.
+	//
+	// GET /api/v1/jobs/{id}
+	APIV1JobsIDGet(ctx context.Context, params APIV1JobsIDGetParams) (APIV1JobsIDGetRes, error)
+	// APIV1JobsPost implements POST /api/v1/jobs operation.
+	//
+	// Queues a category parse and returns immediately. Poll /api/v1/jobs/{id} for progress and results.
+	//
+	// POST /api/v1/jobs
+	APIV1JobsPost(ctx context.Context, req *JobRequest) (APIV1JobsPostRes, error)
+	// APIV1MarketParserCategoriesGet implements GET /api/v1/market-parser/categories operation.
+	//
+	// Sets the delivery address and returns the category tree shown in the market sidebar. Results are
+	// cached per provider, market and address.
+	//
+	// GET /api/v1/market-parser/categories
+	APIV1MarketParserCategoriesGet(ctx context.Context, params APIV1MarketParserCategoriesGetParams) (APIV1MarketParserCategoriesGetRes, error)
+	// APIV1MarketParserMarketsGet implements GET /api/v1/market-parser/markets operation.
+	//
+	// Sets the delivery address on the aggregator home page and lists every retailer available there.
+	// The returned slug is the value expected by the market parameter.
+	//
+	// GET /api/v1/market-parser/markets
+	APIV1MarketParserMarketsGet(ctx context.Context, params APIV1MarketParserMarketsGetParams) (APIV1MarketParserMarketsGetRes, error)
 	// APIV1MarketParserParseGet implements GET /api/v1/market-parser/parse operation.
 	//
-	// Search for products by name and price range.
+	// Collects every product of the category from the store.
 	//
 	// GET /api/v1/market-parser/parse
 	APIV1MarketParserParseGet(ctx context.Context, params APIV1MarketParserParseGetParams) (APIV1MarketParserParseGetRes, error)
+	// APIV1MarketParserParseMarketsGet implements GET /api/v1/market-parser/parse/markets operation.
+	//
+	// Sets the delivery address once and parses the category in every market in parallel tabs of one
+	// browser session. Each market reports its own products or error.
+	//
+	// GET /api/v1/market-parser/parse/markets
+	APIV1MarketParserParseMarketsGet(ctx context.Context, params APIV1MarketParserParseMarketsGetParams) (APIV1MarketParserParseMarketsGetRes, error)
+	// APIV1MarketParserSearchGet implements GET /api/v1/market-parser/search operation.
+	//
+	// Searches the store for products by name and price range. Sorting and the price range are passed to
+	// the store when it supports them and applied to the results otherwise. The store results are saved
+	// as a snapshot with category search:<query>.
+	//
+	// GET /api/v1/market-parser/search
+	APIV1MarketParserSearchGet(ctx context.Context, params APIV1MarketParserSearchGetParams) (APIV1MarketParserSearchGetRes, error)
+	// APIV1ProductsIDHistoryGet implements GET /api/v1/products/{id}/history operation.
+	//
+	// Returns product prices from saved snapshots, oldest first, with min/max/avg over the window.
+	//
+	// GET /api/v1/products/{id}/history
+	APIV1ProductsIDHistoryGet(ctx context.Context, params APIV1ProductsIDHistoryGetParams) (APIV1ProductsIDHistoryGetRes, error)
+	// APIV1SchedulesGet implements GET /api/v1/schedules operation.
+	//
+	// Lists the schedules from config.yaml with the next run time and the outcome of the last run.
+	//
+	// GET /api/v1/schedules
+	APIV1SchedulesGet(ctx context.Context) (APIV1SchedulesGetRes, error)
+	// APIV1SchedulesNameRunsGet implements GET /api/v1/schedules/{name}/runs operation.
+	//
+	// Returns the latest runs of the schedule, newest first.
+	//
+	// GET /api/v1/schedules/{name}/runs
+	APIV1SchedulesNameRunsGet(ctx context.Context, params APIV1SchedulesNameRunsGetParams) (APIV1SchedulesNameRunsGetRes, error)
+	// APIV1SnapshotsGet implements GET /api/v1/snapshots operation.
+	//
+	// Lists snapshots of category parses, newest first. Products are returned only by
+	// /api/v1/snapshots/{id}.
+	//
+	// GET /api/v1/snapshots
+	APIV1SnapshotsGet(ctx context.Context, params APIV1SnapshotsGetParams) (APIV1SnapshotsGetRes, error)
+	// APIV1SnapshotsIDGet implements GET /api/v1/snapshots/{id} operation.
+	//
+	// Saved parse result.
+	//
+	// GET /api/v1/snapshots/{id}
+	APIV1SnapshotsIDGet(ctx context.Context, params APIV1SnapshotsIDGetParams) (APIV1SnapshotsIDGetRes, error)
+	// APIV1WebhooksDeliveriesGet implements GET /api/v1/webhooks/deliveries operation.
+	//
+	// Lists callback_url deliveries, newest first.
+	//
+	// GET /api/v1/webhooks/deliveries
+	APIV1WebhooksDeliveriesGet(ctx context.Context, params APIV1WebhooksDeliveriesGetParams) (APIV1WebhooksDeliveriesGetRes, error)
+	// APIV1WebhooksDeliveriesIDGet implements GET /api/v1/webhooks/deliveries/{id} operation.
+	//
+	// Webhook delivery.
+	//
+	// GET /api/v1/webhooks/deliveries/{id}
+	APIV1WebhooksDeliveriesIDGet(ctx context.Context, params APIV1WebhooksDeliveriesIDGetParams) (APIV1WebhooksDeliveriesIDGetRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
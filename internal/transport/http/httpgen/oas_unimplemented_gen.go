@@ -13,11 +13,220 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// APIV1AlertsGet implements GET /api/v1/alerts operation.
+//
+// Alert rules.
+//
+// GET /api/v1/alerts
+func (UnimplementedHandler) APIV1AlertsGet(ctx context.Context) (r APIV1AlertsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1AlertsIDDelete implements DELETE /api/v1/alerts/{id} operation.
+//
+// Delete alert rule.
+//
+// DELETE /api/v1/alerts/{id}
+func (UnimplementedHandler) APIV1AlertsIDDelete(ctx context.Context, params APIV1AlertsIDDeleteParams) (r APIV1AlertsIDDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1AlertsIDGet implements GET /api/v1/alerts/{id} operation.
+//
+// Alert rule.
+//
+// GET /api/v1/alerts/{id}
+func (UnimplementedHandler) APIV1AlertsIDGet(ctx context.Context, params APIV1AlertsIDGetParams) (r APIV1AlertsIDGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1AlertsIDPut implements PUT /api/v1/alerts/{id} operation.
+//
+// Replace alert rule.
+//
+// PUT /api/v1/alerts/{id}
+func (UnimplementedHandler) APIV1AlertsIDPut(ctx context.Context, req *AlertRuleRequest, params APIV1AlertsIDPutParams) (r APIV1AlertsIDPutRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1AlertsPost implements POST /api/v1/alerts operation.
+//
+// The rule is checked against the previous snapshot of the same market, address and category every
+// time a parse finishes.
+//
+// POST /api/v1/alerts
+func (UnimplementedHandler) APIV1AlertsPost(ctx context.Context, req *AlertRuleRequest) (r APIV1AlertsPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1DiagnosticsSelectorsGet implements GET /api/v1/diagnostics/selectors operation.
+//
+// Walks the parsing flow of the provider (home page, delivery address, market cards, market page and,
+//
+//	with category, the category product list) and reports every configured selector as found, missing,
+//	not_visible or skipped. A PNG screenshot of the page is attached to each failed required selector.
+//	Intended as a canary before scheduled crawls: check the healthy flag.
+//
+// GET /api/v1/diagnostics/selectors
+func (UnimplementedHandler) APIV1DiagnosticsSelectorsGet(ctx context.Context, params APIV1DiagnosticsSelectorsGetParams) (r APIV1DiagnosticsSelectorsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1DiffGet implements GET /api/v1/diff operation.
+//
+// Compares two saved snapshots of the same provider, market, address and category.
+//
+// GET /api/v1/diff
+func (UnimplementedHandler) APIV1DiffGet(ctx context.Context, params APIV1DiffGetParams) (r APIV1DiffGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1DiffPost implements POST /api/v1/diff operation.
+//
+// Compares two saved /parse responses of the same market, address and category.
+//
+// POST /api/v1/diff
+func (UnimplementedHandler) APIV1DiffPost(ctx context.Context, req *DiffRequest) (r APIV1DiffPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1JobsIDDelete implements DELETE /api/v1/jobs/{id} operation.
+//
+// Cancels a queued job or interrupts a running one. A finished job is returned unchanged.
+//
+// DELETE /api/v1/jobs/{id}
+func (UnimplementedHandler) APIV1JobsIDDelete(ctx context.Context, params APIV1JobsIDDeleteParams) (r APIV1JobsIDDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1JobsIDGet implements GET /api/v1/jobs/{id} operation.
+//
+// Returns job status, page progress and, once done, the parsed products.
+//
+// GET /api/v1/jobs/{id}
+func (UnimplementedHandler) APIV1JobsIDGet(ctx context.Context, params APIV1JobsIDGetParams) (r APIV1JobsIDGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1JobsPost implements POST /api/v1/jobs operation.
+//
+// Queues a category parse and returns immediately. Poll /api/v1/jobs/{id} for progress and results.
+//
+// POST /api/v1/jobs
+func (UnimplementedHandler) APIV1JobsPost(ctx context.Context, req *JobRequest) (r APIV1JobsPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketParserCategoriesGet implements GET /api/v1/market-parser/categories operation.
+//
+// Sets the delivery address and returns the category tree shown in the market sidebar. Results are
+// cached per provider, market and address.
+//
+// GET /api/v1/market-parser/categories
+func (UnimplementedHandler) APIV1MarketParserCategoriesGet(ctx context.Context, params APIV1MarketParserCategoriesGetParams) (r APIV1MarketParserCategoriesGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketParserMarketsGet implements GET /api/v1/market-parser/markets operation.
+//
+// Sets the delivery address on the aggregator home page and lists every retailer available there.
+// The returned slug is the value expected by the market parameter.
+//
+// GET /api/v1/market-parser/markets
+func (UnimplementedHandler) APIV1MarketParserMarketsGet(ctx context.Context, params APIV1MarketParserMarketsGetParams) (r APIV1MarketParserMarketsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // APIV1MarketParserParseGet implements GET /api/v1/market-parser/parse operation.
 //
-// Search for products by name and price range.
+// Collects every product of the category from the store.
 //
 // GET /api/v1/market-parser/parse
 func (UnimplementedHandler) APIV1MarketParserParseGet(ctx context.Context, params APIV1MarketParserParseGetParams) (r APIV1MarketParserParseGetRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
+
+// APIV1MarketParserParseMarketsGet implements GET /api/v1/market-parser/parse/markets operation.
+//
+// Sets the delivery address once and parses the category in every market in parallel tabs of one
+// browser session. Each market reports its own products or error.
+//
+// GET /api/v1/market-parser/parse/markets
+func (UnimplementedHandler) APIV1MarketParserParseMarketsGet(ctx context.Context, params APIV1MarketParserParseMarketsGetParams) (r APIV1MarketParserParseMarketsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketParserSearchGet implements GET /api/v1/market-parser/search operation.
+//
+// Searches the store for products by name and price range. Sorting and the price range are passed to
+// the store when it supports them and applied to the results otherwise. The store results are saved
+// as a snapshot with category search:<query>.
+//
+// GET /api/v1/market-parser/search
+func (UnimplementedHandler) APIV1MarketParserSearchGet(ctx context.Context, params APIV1MarketParserSearchGetParams) (r APIV1MarketParserSearchGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1ProductsIDHistoryGet implements GET /api/v1/products/{id}/history operation.
+//
+// Returns product prices from saved snapshots, oldest first, with min/max/avg over the window.
+//
+// GET /api/v1/products/{id}/history
+func (UnimplementedHandler) APIV1ProductsIDHistoryGet(ctx context.Context, params APIV1ProductsIDHistoryGetParams) (r APIV1ProductsIDHistoryGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1SchedulesGet implements GET /api/v1/schedules operation.
+//
+// Lists the schedules from config.yaml with the next run time and the outcome of the last run.
+//
+// GET /api/v1/schedules
+func (UnimplementedHandler) APIV1SchedulesGet(ctx context.Context) (r APIV1SchedulesGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1SchedulesNameRunsGet implements GET /api/v1/schedules/{name}/runs operation.
+//
+// Returns the latest runs of the schedule, newest first.
+//
+// GET /api/v1/schedules/{name}/runs
+func (UnimplementedHandler) APIV1SchedulesNameRunsGet(ctx context.Context, params APIV1SchedulesNameRunsGetParams) (r APIV1SchedulesNameRunsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1SnapshotsGet implements GET /api/v1/snapshots operation.
+//
+// Lists snapshots of category parses, newest first. Products are returned only by
+// /api/v1/snapshots/{id}.
+//
+// GET /api/v1/snapshots
+func (UnimplementedHandler) APIV1SnapshotsGet(ctx context.Context, params APIV1SnapshotsGetParams) (r APIV1SnapshotsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1SnapshotsIDGet implements GET /api/v1/snapshots/{id} operation.
+//
+// Saved parse result.
+//
+// GET /api/v1/snapshots/{id}
+func (UnimplementedHandler) APIV1SnapshotsIDGet(ctx context.Context, params APIV1SnapshotsIDGetParams) (r APIV1SnapshotsIDGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1WebhooksDeliveriesGet implements GET /api/v1/webhooks/deliveries operation.
+//
+// Lists callback_url deliveries, newest first.
+//
+// GET /api/v1/webhooks/deliveries
+func (UnimplementedHandler) APIV1WebhooksDeliveriesGet(ctx context.Context, params APIV1WebhooksDeliveriesGetParams) (r APIV1WebhooksDeliveriesGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1WebhooksDeliveriesIDGet implements GET /api/v1/webhooks/deliveries/{id} operation.
+//
+// Webhook delivery.
+//
+// GET /api/v1/webhooks/deliveries/{id}
+func (UnimplementedHandler) APIV1WebhooksDeliveriesIDGet(ctx context.Context, params APIV1WebhooksDeliveriesIDGetParams) (r APIV1WebhooksDeliveriesIDGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
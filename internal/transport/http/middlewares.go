@@ -57,9 +57,27 @@ func (rw *responseWriter) WriteHeader(statusCode int) {
 	rw.ResponseWriter.WriteHeader(statusCode)
 }
 
+// Flush нужен потоковым ответам: без него обёртка скрывает http.Flusher исходного writer.
+func (rw *responseWriter) Flush() {
+	if f, ok := rw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
+// RequestTimeoutMiddleware ограничивает запрос server.request_timeout. Потоковый парсинг
+// отдаёт товары по мере сбора и получает свой, более длинный лимит server.stream_timeout.
 func (h *Handler) RequestTimeoutMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+		timeout := h.requestTimeout
+		if r.URL.Path == ParseStreamPath {
+			timeout = h.streamTimeout
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
 		defer cancel()
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
@@ -0,0 +1,187 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/transport/http/httpgen"
+)
+
+const ParseStreamPath = "/api/v1/market-parser/parse/stream"
+
+const (
+	streamFormatNDJSON = "ndjson"
+	streamFormatSSE    = "sse"
+)
+
+// streamEvent - одно событие потока. Type: product, progress, summary или error.
+type streamEvent struct {
+	Type       string                 `json:"type"`
+	Product    *httpgen.Product       `json:"product,omitempty"`
+	PagesDone  *int                   `json:"pages_done,omitempty"`
+	PagesTotal *int                   `json:"pages_total,omitempty"`
+	Products   *int                   `json:"products,omitempty"`
+	DurationMs *int64                 `json:"duration_ms,omitempty"`
+	Error      *httpgen.ErrorResponse `json:"error,omitempty"`
+}
+
+// ParseStream - потоковый вариант /parse. Ogen не генерирует ответы, отдаваемые частями,
+// поэтому обработчик подключается к mux напрямую, в обход сгенерированного сервера.
+// Формат выбирается параметром format (ndjson, sse) или заголовком Accept: text/event-stream.
+func (h *Handler) ParseStream(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	format := q.Get("format")
+	if format == "" {
+		format = streamFormatNDJSON
+		if strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+			format = streamFormatSSE
+		}
+	}
+	if format != streamFormatNDJSON && format != streamFormatSSE {
+		writeError(w, &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest})
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	events := make(chan streamEvent, 100)
+	send := func(e streamEvent) {
+		select {
+		case events <- e:
+		case <-ctx.Done():
+		}
+	}
+
+	mu := sync.Mutex{}
+	pagesDone, pagesTotal, products := 0, 0, 0
+	progress := func() streamEvent {
+		done, total := pagesDone, pagesTotal
+		return streamEvent{Type: "progress", PagesDone: &done, PagesTotal: &total}
+	}
+
+	hooks := &domain.ParseHooks{
+		Stream: true,
+		OnPages: func(total int) {
+			mu.Lock()
+			pagesTotal += total
+			e := progress()
+			mu.Unlock()
+			send(e)
+		},
+		OnPage: func(pageNum int, _ []domain.Products) {
+			mu.Lock()
+			pagesDone++
+			e := progress()
+			mu.Unlock()
+			send(e)
+		},
+		OnProduct: func(p domain.Products) {
+			mu.Lock()
+			products++
+			mu.Unlock()
+			product := toProduct(p)
+			send(streamEvent{Type: "product", Product: &product})
+		},
+	}
+
+	start := time.Now()
+	parseErr := make(chan error, 1)
+	go func() {
+		defer close(events)
+		_, err := h.parserSrv.ParseProductsByCategory(domain.WithParseHooks(ctx, hooks), q.Get("provider"), q.Get("category"), q.Get("address"), q.Get("market"), domain.ParseMode(q.Get("mode")))
+		parseErr <- err
+	}()
+
+	// заголовки отправляются с первым событием: ошибку валидации ещё можно вернуть обычным статусом
+	var err error
+	first, ok := <-events
+	if !ok {
+		if err = <-parseErr; err != nil {
+			httpErr := MapError(err)
+			h.LogHTTPError(ctx, err, httpErr)
+			writeError(w, httpErr)
+			return
+		}
+	}
+
+	if format == streamFormatSSE {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+	} else {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	}
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	write := func(e streamEvent) error {
+		if err := writeEvent(w, format, e); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+
+	if ok {
+		if err := write(first); err != nil {
+			h.logger.Warn("write stream event", "error", err)
+			return
+		}
+		for e := range events {
+			if err := write(e); err != nil {
+				// клиент отключился, отмена ctx останавливает парсинг
+				h.logger.Warn("write stream event", "error", err)
+				cancel()
+				for range events {
+				}
+				return
+			}
+		}
+		err = <-parseErr
+	}
+
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		write(streamEvent{Type: "error", Error: &httpgen.ErrorResponse{Message: httpErr.Message, Status: httpErr.Status}})
+		return
+	}
+
+	mu.Lock()
+	done, total, count := pagesDone, pagesTotal, products
+	mu.Unlock()
+	durationMs := time.Since(start).Milliseconds()
+	write(streamEvent{Type: "summary", PagesDone: &done, PagesTotal: &total, Products: &count, DurationMs: &durationMs})
+}
+
+func writeEvent(w http.ResponseWriter, format string, e streamEvent) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	if format == streamFormatSSE {
+		_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, data)
+	} else {
+		_, err = fmt.Fprintf(w, "%s\n", data)
+	}
+
+	return err
+}
+
+func writeError(w http.ResponseWriter, httpErr *HTTPError) {
+	w.Header().Set("Content-Type", "application/json")
+	if httpErr.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(httpErr.RetryAfter))
+	}
+	w.WriteHeader(httpErr.Status)
+	json.NewEncoder(w).Encode(httpgen.ErrorResponse{Message: httpErr.Message, Status: httpErr.Status})
+}
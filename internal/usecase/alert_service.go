@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+type AlertService interface {
+	CreateRule(ctx context.Context, rule domain.AlertRule) (*domain.AlertRule, error)
+	UpdateRule(ctx context.Context, id string, rule domain.AlertRule) (*domain.AlertRule, error)
+	GetRule(ctx context.Context, id string) (*domain.AlertRule, error)
+	ListRules(ctx context.Context) ([]domain.AlertRule, error)
+	DeleteRule(ctx context.Context, id string) error
+	Check(ctx context.Context, prev *domain.Snapshot, cur *domain.Snapshot)
+}
+
+// alertService проверяет правила на каждом новом снимке и рассылает оповещения в фоне.
+// Оповещение отмечается отправленным только после успешной отправки, поэтому неудачное
+// повторится на следующем снимке, если условие ещё выполняется.
+type alertService struct {
+	alertRepo     repository.AlertRepository
+	notifiers     map[domain.AlertNotifier]repository.AlertNotifier
+	notifyTimeout time.Duration
+	logger        logger.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewAlertService(logger logger.Logger, alertRepo repository.AlertRepository, notifiers map[domain.AlertNotifier]repository.AlertNotifier, notifyTimeout time.Duration) *alertService {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &alertService{
+		alertRepo:     alertRepo,
+		notifiers:     notifiers,
+		notifyTimeout: notifyTimeout,
+		logger:        logger,
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+func (s *alertService) CreateRule(ctx context.Context, rule domain.AlertRule) (*domain.AlertRule, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, fmt.Errorf("validate alert rule: %w", err)
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, fmt.Errorf("new alert rule id: %w", err)
+	}
+	rule.ID = id
+	rule.CreatedAt = time.Now()
+	rule.UpdatedAt = rule.CreatedAt
+
+	if err := s.alertRepo.SaveRule(ctx, &rule); err != nil {
+		return nil, fmt.Errorf("save alert rule: %w", err)
+	}
+
+	return &rule, nil
+}
+
+func (s *alertService) UpdateRule(ctx context.Context, id string, rule domain.AlertRule) (*domain.AlertRule, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, fmt.Errorf("validate alert rule: %w", err)
+	}
+
+	existing, err := s.alertRepo.GetRule(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get alert rule: %w", err)
+	}
+	rule.ID = existing.ID
+	rule.CreatedAt = existing.CreatedAt
+	rule.UpdatedAt = time.Now()
+
+	if err := s.alertRepo.SaveRule(ctx, &rule); err != nil {
+		return nil, fmt.Errorf("save alert rule: %w", err)
+	}
+
+	return &rule, nil
+}
+
+func (s *alertService) GetRule(ctx context.Context, id string) (*domain.AlertRule, error) {
+	res, err := s.alertRepo.GetRule(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get alert rule: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *alertService) ListRules(ctx context.Context) ([]domain.AlertRule, error) {
+	res, err := s.alertRepo.ListRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list alert rules: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *alertService) DeleteRule(ctx context.Context, id string) error {
+	if err := s.alertRepo.DeleteRule(ctx, id); err != nil {
+		return fmt.Errorf("delete alert rule: %w", err)
+	}
+
+	return nil
+}
+
+// Check сравнивает снимок cur с предыдущим снимком той же категории prev и отправляет
+// оповещения сработавших правил. Ошибки только логируются: снимок уже сохранён.
+func (s *alertService) Check(ctx context.Context, prev *domain.Snapshot, cur *domain.Snapshot) {
+	rules, err := s.alertRepo.ListRules(ctx)
+	if err != nil {
+		s.logger.Error("list alert rules", "error", err)
+		return
+	}
+
+	for _, rule := range rules {
+		if !rule.Enabled {
+			continue
+		}
+
+		events := rule.Evaluate(prev, cur)
+		fresh, err := s.dedup(ctx, &rule, cur, events)
+		if err != nil {
+			s.logger.Error("dedup alerts", "rule_id", rule.ID, "error", err)
+			continue
+		}
+		if len(fresh) == 0 {
+			continue
+		}
+
+		notifier, ok := s.notifiers[rule.Notifier]
+		if !ok {
+			s.logger.Warn("alert notifier is not configured", "rule_id", rule.ID, "notifier", rule.Notifier)
+			continue
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.notify(notifier, &rule, fresh)
+		}()
+	}
+}
+
+// dedup отбрасывает события, о которых уже оповещали с тем же условием, и снимает отметки
+// с товаров категории, для которых условие больше не выполняется.
+func (s *alertService) dedup(ctx context.Context, rule *domain.AlertRule, cur *domain.Snapshot, events []domain.AlertEvent) ([]domain.AlertEvent, error) {
+	fired, err := s.alertRepo.FiredAlerts(ctx, rule.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fired alerts: %w", err)
+	}
+
+	active := make(map[string]struct{}, len(events))
+	res := []domain.AlertEvent{}
+	for _, e := range events {
+		active[e.Key] = struct{}{}
+		if fired[e.Key] != e.Fingerprint {
+			res = append(res, e)
+		}
+	}
+
+	prefix := domain.AlertTargetKey(cur.Provider, cur.Market, cur.Address, cur.Category)
+	for key := range fired {
+		if _, ok := active[key]; ok || !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if err := s.alertRepo.SaveFiredAlert(ctx, rule.ID, key, ""); err != nil {
+			return nil, fmt.Errorf("clear fired alert: %w", err)
+		}
+	}
+
+	return res, nil
+}
+
+func (s *alertService) notify(notifier repository.AlertNotifier, rule *domain.AlertRule, events []domain.AlertEvent) {
+	ctx, cancel := context.WithTimeout(s.ctx, s.notifyTimeout)
+	defer cancel()
+
+	if err := notifier.Notify(ctx, rule, events); err != nil {
+		s.logger.Warn("alert notification failed", "rule_id", rule.ID, "notifier", rule.Notifier, "events", len(events), "error", err)
+		return
+	}
+
+	for _, e := range events {
+		if err := s.alertRepo.SaveFiredAlert(context.WithoutCancel(s.ctx), rule.ID, e.Key, e.Fingerprint); err != nil {
+			s.logger.Error("save fired alert", "rule_id", rule.ID, "error", err)
+		}
+	}
+	s.logger.Info("alert sent", "rule_id", rule.ID, "notifier", rule.Notifier, "events", len(events))
+}
+
+// Close прерывает отправки, которые не уложились в остановку сервиса, и ждёт остальные.
+func (s *alertService) Close(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		s.cancel()
+		return nil
+	case <-ctx.Done():
+		s.cancel()
+		return fmt.Errorf("wait alert notifications: %w", ctx.Err())
+	}
+}
@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/adapters/storage/memory"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+// recordingNotifier запоминает отправленные события и возвращает заданные ошибки по очереди.
+type recordingNotifier struct {
+	mu     sync.Mutex
+	sent   [][]domain.AlertEvent
+	errors []error
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, rule *domain.AlertRule, events []domain.AlertEvent) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if len(n.errors) > 0 {
+		err := n.errors[0]
+		n.errors = n.errors[1:]
+		if err != nil {
+			return err
+		}
+	}
+	n.sent = append(n.sent, events)
+
+	return nil
+}
+
+func (n *recordingNotifier) count() int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return len(n.sent)
+}
+
+func newTestAlertService(t *testing.T, notifier *recordingNotifier) *alertService {
+	t.Helper()
+
+	log := logger.LoadLogger(logger.NewLoggerConfig("local", time.Kitchen).WithOutput(io.Discard))
+	s := NewAlertService(log, memory.NewAlertRepository(), map[domain.AlertNotifier]repository.AlertNotifier{domain.AlertNotifierLog: notifier}, time.Second)
+	t.Cleanup(func() { s.Close(context.Background()) })
+
+	_, err := s.CreateRule(context.Background(), domain.AlertRule{Name: "cheap milk", Kind: domain.AlertKindPriceBelow, Threshold: 100, Notifier: domain.AlertNotifierLog, Enabled: true})
+	if err != nil {
+		t.Fatalf("create rule: %v", err)
+	}
+
+	return s
+}
+
+func milkSnapshot(price float64) *domain.Snapshot {
+	return &domain.Snapshot{Market: "metro", Address: "Москва, Тверская улица, 1", Category: "Молоко", Products: []domain.Products{
+		{Name: "milk", Price: price, URL: "https://kuper.ru/metro/milk"},
+	}}
+}
+
+func TestAlertServiceCheckDedup(t *testing.T) {
+	notifier := &recordingNotifier{}
+	s := newTestAlertService(t, notifier)
+
+	// check дожидается фоновой отправки, чтобы следующая проверка видела её отметку
+	check := func(prev *domain.Snapshot, cur *domain.Snapshot) {
+		s.Check(context.Background(), prev, cur)
+		s.wg.Wait()
+	}
+
+	steps := []struct {
+		name  string
+		price float64
+		want  int
+	}{
+		{name: "condition met", price: 50, want: 1},
+		{name: "same condition", price: 50, want: 1},
+		{name: "still below threshold", price: 70, want: 1},
+		{name: "condition cleared", price: 150, want: 1},
+		{name: "condition met again", price: 60, want: 2},
+		{name: "same condition after re-arm", price: 60, want: 2},
+	}
+
+	var prev *domain.Snapshot
+	for _, step := range steps {
+		cur := milkSnapshot(step.price)
+		check(prev, cur)
+		prev = cur
+
+		if got := notifier.count(); got != step.want {
+			t.Fatalf("%s: got %d notifications, want %d", step.name, got, step.want)
+		}
+	}
+}
+
+func TestAlertServiceCheckRetriesFailedNotification(t *testing.T) {
+	notifier := &recordingNotifier{errors: []error{errors.New("telegram is down")}}
+	s := newTestAlertService(t, notifier)
+
+	s.Check(context.Background(), nil, milkSnapshot(50))
+	s.wg.Wait()
+	if got := notifier.count(); got != 0 {
+		t.Fatalf("got %d notifications after failed send, want 0", got)
+	}
+
+	// неудачная отправка не отмечается, поэтому оповещение уходит на следующем снимке
+	s.Check(context.Background(), milkSnapshot(50), milkSnapshot(50))
+	s.wg.Wait()
+	if got := notifier.count(); got != 1 {
+		t.Fatalf("got %d notifications after retry, want 1", got)
+	}
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+type cacheItem[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// ttlCache - потокобезопасный in-memory кэш с общим временем жизни записей.
+type ttlCache[V any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	items   map[string]cacheItem[V]
+	sweptAt time.Time
+	loads   singleflight.Group
+}
+
+func newTTLCache[V any](ttl time.Duration) *ttlCache[V] {
+	return &ttlCache[V]{ttl: ttl, items: make(map[string]cacheItem[V]), sweptAt: time.Now()}
+}
+
+func (c *ttlCache[V]) Get(key string) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, ok := c.items[key]
+	if !ok || time.Now().After(item.expiresAt) {
+		delete(c.items, key)
+		var zero V
+		return zero, false
+	}
+
+	return item.value, true
+}
+
+func (c *ttlCache[V]) Set(key string, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	// записи, которые больше не запрашивают, Get не удалит, поэтому не чаще раза за ttl просроченные вычищаются здесь
+	if now.Sub(c.sweptAt) >= c.ttl {
+		for k, item := range c.items {
+			if now.After(item.expiresAt) {
+				delete(c.items, k)
+			}
+		}
+		c.sweptAt = now
+	}
+
+	c.items[key] = cacheItem[V]{value: value, expiresAt: now.Add(c.ttl)}
+}
+
+// Load возвращает запись key, а при промахе загружает её через load и кэширует. Одновременные промахи
+// по одному key ждут одну загрузку, запущенную с ctx первого из них; ошибка загрузки не кэшируется.
+func (c *ttlCache[V]) Load(ctx context.Context, key string, load func(ctx context.Context) (V, error)) (V, error) {
+	if value, ok := c.Get(key); ok {
+		return value, nil
+	}
+
+	ch := c.loads.DoChan(key, func() (any, error) {
+		value, err := load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.Set(key, value)
+		return value, nil
+	})
+
+	var zero V
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return zero, res.Err
+		}
+		return res.Val.(V), nil
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
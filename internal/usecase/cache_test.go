@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTTLCacheLoadDedupesInFlight(t *testing.T) {
+	c := newTTLCache[int](time.Minute)
+
+	var loads atomic.Int32
+	release := make(chan struct{})
+	load := func(ctx context.Context) (int, error) {
+		loads.Add(1)
+		<-release
+		return 42, nil
+	}
+
+	wg := sync.WaitGroup{}
+	results := make([]int, 10)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := c.Load(context.Background(), "metro", load)
+			if err != nil {
+				t.Errorf("load: %v", err)
+			}
+			results[i] = v
+		}()
+	}
+	// даём горутинам встать в ожидание одной загрузки
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := loads.Load(); got != 1 {
+		t.Errorf("got %d loads, want 1", got)
+	}
+	for i, v := range results {
+		if v != 42 {
+			t.Errorf("result %d = %d, want 42", i, v)
+		}
+	}
+
+	if _, err := c.Load(context.Background(), "metro", load); err != nil || loads.Load() != 1 {
+		t.Errorf("cached load: err = %v, loads = %d, want cache hit", err, loads.Load())
+	}
+}
+
+func TestTTLCacheLoadDoesNotCacheErrors(t *testing.T) {
+	c := newTTLCache[int](time.Minute)
+	loadErr := errors.New("captcha")
+
+	calls := 0
+	load := func(ctx context.Context) (int, error) {
+		calls++
+		if calls == 1 {
+			return 0, loadErr
+		}
+		return 7, nil
+	}
+
+	if _, err := c.Load(context.Background(), "metro", load); !errors.Is(err, loadErr) {
+		t.Fatalf("first load error = %v, want %v", err, loadErr)
+	}
+	if v, err := c.Load(context.Background(), "metro", load); err != nil || v != 7 {
+		t.Errorf("second load = %d, %v, want 7", v, err)
+	}
+}
+
+func TestTTLCacheSetSweepsExpired(t *testing.T) {
+	c := newTTLCache[int](10 * time.Millisecond)
+	c.Set("a", 1)
+	c.Set("b", 2)
+
+	time.Sleep(20 * time.Millisecond)
+	c.Set("c", 3)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.items) != 1 {
+		t.Errorf("got %d items after sweep, want 1", len(c.items))
+	}
+}
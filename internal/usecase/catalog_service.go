@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+)
+
+type CatalogService interface {
+	GetCategories(ctx context.Context, provider string, market string, address string) ([]domain.Category, error)
+	GetMarkets(ctx context.Context, provider string, address string) ([]domain.Market, error)
+}
+
+type catalogService struct {
+	registry   repository.ParserRegistry
+	categories *ttlCache[[]domain.Category]
+	markets    *ttlCache[[]domain.Market]
+}
+
+func NewCatalogService(registry repository.ParserRegistry, cacheTTL time.Duration) *catalogService {
+	return &catalogService{
+		registry:   registry,
+		categories: newTTLCache[[]domain.Category](cacheTTL),
+		markets:    newTTLCache[[]domain.Market](cacheTTL),
+	}
+}
+
+func (s *catalogService) GetCategories(ctx context.Context, provider string, market string, address string) ([]domain.Category, error) {
+	if market == "" {
+		return nil, domain.ErrEmptyMarket
+	}
+
+	if address == "" {
+		return nil, domain.ErrEmptyAddress
+	}
+
+	catalogRepo, err := s.registry.Catalog(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	// дерево категорий зависит от агрегатора, магазина и адреса доставки
+	key := provider + "|" + market + "|" + domain.NormalizeAddress(address)
+	res, err := s.categories.Load(ctx, key, func(ctx context.Context) ([]domain.Category, error) {
+		return catalogRepo.GetCategories(ctx, address, market)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get categories: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *catalogService) GetMarkets(ctx context.Context, provider string, address string) ([]domain.Market, error) {
+	if address == "" {
+		return nil, domain.ErrEmptyAddress
+	}
+
+	catalogRepo, err := s.registry.Catalog(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	key := provider + "|" + domain.NormalizeAddress(address)
+	res, err := s.markets.Load(ctx, key, func(ctx context.Context) ([]domain.Market, error) {
+		return catalogRepo.GetMarkets(ctx, address)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get markets: %w", err)
+	}
+
+	return res, nil
+}
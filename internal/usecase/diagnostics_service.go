@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+)
+
+type DiagnosticsService interface {
+	CheckSelectors(ctx context.Context, provider string, market string, address string, category string) (*domain.SelectorReport, error)
+}
+
+type diagnosticsService struct {
+	registry repository.ParserRegistry
+}
+
+func NewDiagnosticsService(registry repository.ParserRegistry) *diagnosticsService {
+	return &diagnosticsService{registry: registry}
+}
+
+// CheckSelectors проверяет селекторы агрегатора на живом сайте. Пустая category пропускает
+// проверку страниц категории.
+func (s *diagnosticsService) CheckSelectors(ctx context.Context, provider string, market string, address string, category string) (*domain.SelectorReport, error) {
+	if market == "" {
+		return nil, domain.ErrEmptyMarket
+	}
+
+	if address == "" {
+		return nil, domain.ErrEmptyAddress
+	}
+
+	checker, err := s.registry.Checker(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	if provider == "" {
+		provider = s.registry.DefaultProvider()
+	}
+	report := &domain.SelectorReport{
+		Provider:  provider,
+		Market:    market,
+		Address:   address,
+		Category:  category,
+		StartedAt: time.Now(),
+	}
+
+	report.Checks, err = checker.CheckSelectors(ctx, address, market, category)
+	if err != nil {
+		return nil, fmt.Errorf("check selectors: %w", err)
+	}
+	report.Duration = time.Since(report.StartedAt)
+
+	return report, nil
+}
@@ -0,0 +1,299 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+)
+
+type JobService interface {
+	Submit(ctx context.Context, params domain.JobParams) (*domain.Job, error)
+	Get(ctx context.Context, id string) (*domain.Job, error)
+	Cancel(ctx context.Context, id string) (*domain.Job, error)
+}
+
+type job struct {
+	mu     sync.Mutex
+	state  domain.Job
+	cancel context.CancelFunc
+}
+
+func (j *job) snapshot() *domain.Job {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	res := j.state
+	return &res
+}
+
+// jobService выполняет парсинг категорий в фоне: задачи ждут в очереди ограниченного размера,
+// а фиксированное число воркеров передаёт их в ParserService. Задачи хранятся в памяти
+// и удаляются через retention после завершения.
+type jobService struct {
+	parserSrv            ParserService
+	webhookSrv           WebhookService
+	timeout              time.Duration
+	retention            time.Duration
+	callbackAllowedHosts []string
+
+	mu     sync.Mutex
+	jobs   map[string]*job
+	queue  chan *job
+	closed bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewJobService создаёт сервис задач. Результаты задач с callback_url передаются в webhookSrv.
+// callback_url на внутренние адреса принимается только для хостов из callbackAllowedHosts.
+func NewJobService(parserSrv ParserService, webhookSrv WebhookService, workers int, queueSize int, timeout time.Duration, retention time.Duration, callbackAllowedHosts []string) *jobService {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := &jobService{
+		parserSrv:            parserSrv,
+		webhookSrv:           webhookSrv,
+		timeout:              timeout,
+		retention:            retention,
+		callbackAllowedHosts: callbackAllowedHosts,
+		jobs:                 make(map[string]*job),
+		queue:                make(chan *job, max(queueSize, 0)),
+		ctx:                  ctx,
+		cancel:               cancel,
+	}
+
+	for range max(workers, 1) {
+		s.wg.Add(1)
+		go s.worker()
+	}
+
+	return s
+}
+
+func (s *jobService) Submit(ctx context.Context, params domain.JobParams) (*domain.Job, error) {
+	if params.Category == "" {
+		return nil, domain.ErrEmptyCategory
+	}
+
+	if params.Address == "" {
+		return nil, domain.ErrEmptyAddress
+	}
+
+	if params.Market == "" {
+		return nil, domain.ErrEmptyMarket
+	}
+
+	if params.CallbackURL != "" {
+		if err := domain.ValidateCallbackURL(params.CallbackURL, s.callbackAllowedHosts); err != nil {
+			return nil, err
+		}
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, fmt.Errorf("new job id: %w", err)
+	}
+
+	j := &job{state: domain.Job{
+		ID:        id,
+		Params:    params,
+		Status:    domain.JobStatusQueued,
+		CreatedAt: time.Now(),
+	}}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, domain.ErrJobQueueClosed
+	}
+	s.evictExpired()
+
+	select {
+	case s.queue <- j:
+	default:
+		return nil, domain.ErrJobQueueFull
+	}
+	s.jobs[id] = j
+
+	return j.snapshot(), nil
+}
+
+func (s *jobService) Get(ctx context.Context, id string) (*domain.Job, error) {
+	j, err := s.job(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return j.snapshot(), nil
+}
+
+// Cancel отменяет задачу в очереди или прерывает выполняющуюся через её context.
+// Завершённая задача возвращается без изменений.
+func (s *jobService) Cancel(ctx context.Context, id string) (*domain.Job, error) {
+	j, err := s.job(id)
+	if err != nil {
+		return nil, err
+	}
+
+	j.mu.Lock()
+	if !j.state.Status.Finished() {
+		j.state.Status = domain.JobStatusCanceled
+		j.state.FinishedAt = time.Now()
+		if j.cancel != nil {
+			j.cancel()
+		}
+	}
+	j.mu.Unlock()
+
+	return j.snapshot(), nil
+}
+
+// Close перестаёт принимать задачи, прерывает выполняющиеся и ждёт остановки воркеров.
+func (s *jobService) Close(ctx context.Context) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	s.cancel()
+	close(s.queue)
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("wait job workers: %w", ctx.Err())
+	}
+}
+
+func (s *jobService) job(id string) (*job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.evictExpired()
+
+	j, ok := s.jobs[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", domain.ErrJobNotFound, id)
+	}
+
+	return j, nil
+}
+
+// evictExpired удаляет задачи, завершённые раньше чем retention назад. Вызывается под s.mu.
+func (s *jobService) evictExpired() {
+	now := time.Now()
+	for id, j := range s.jobs {
+		j.mu.Lock()
+		expired := j.state.Status.Finished() && now.Sub(j.state.FinishedAt) > s.retention
+		j.mu.Unlock()
+		if expired {
+			delete(s.jobs, id)
+		}
+	}
+}
+
+func (s *jobService) worker() {
+	defer s.wg.Done()
+
+	for j := range s.queue {
+		s.run(j)
+	}
+}
+
+func (s *jobService) run(j *job) {
+	ctx, cancel := context.WithTimeout(s.ctx, s.timeout)
+	defer cancel()
+
+	j.mu.Lock()
+	// задачу отменили, пока она ждала в очереди
+	if j.state.Status != domain.JobStatusQueued {
+		j.mu.Unlock()
+		return
+	}
+	// сервис останавливается, оставшиеся в очереди задачи не запускаются
+	if s.ctx.Err() != nil {
+		j.state.Status = domain.JobStatusCanceled
+		j.state.FinishedAt = time.Now()
+		j.mu.Unlock()
+		return
+	}
+	j.state.Status = domain.JobStatusRunning
+	j.state.StartedAt = time.Now()
+	j.cancel = cancel
+	params := j.state.Params
+	j.mu.Unlock()
+
+	hooks := &domain.ParseHooks{
+		OnPages: func(total int) {
+			j.mu.Lock()
+			j.state.PagesTotal += total
+			j.mu.Unlock()
+		},
+		OnPage: func(pageNum int, products []domain.Products) {
+			j.mu.Lock()
+			j.state.PagesDone++
+			j.mu.Unlock()
+		},
+	}
+	ctx = domain.WithParseHooks(ctx, hooks)
+	ctx = domain.WithSnapshotSource(ctx, domain.SnapshotSourceJob)
+
+	res, err := s.parserSrv.ParseProductsByCategory(ctx, params.Provider, params.Category, params.Address, params.Market, params.Mode)
+
+	j.mu.Lock()
+	j.cancel = nil
+	if j.state.Status == domain.JobStatusCanceled {
+		j.mu.Unlock()
+		return
+	}
+	j.state.FinishedAt = time.Now()
+	switch {
+	case err != nil && s.ctx.Err() != nil:
+		j.state.Status = domain.JobStatusCanceled
+	case err != nil && len(res) > 0 && domain.FailedPages(err) != nil:
+		j.state.Status = domain.JobStatusDone
+		j.state.Products = res
+		j.state.FailedPages = domain.FailedPages(err)
+	case err != nil:
+		j.state.Status = domain.JobStatusFailed
+		j.state.Err = err
+	default:
+		j.state.Status = domain.JobStatusDone
+		j.state.Products = res
+		// api-режим останавливается на первой пустой странице раньше lastPageNum
+		j.state.PagesDone = j.state.PagesTotal
+	}
+	finished := j.state
+	j.mu.Unlock()
+
+	// отменённые задачи не отправляются: об отмене вызывающий знает сам
+	if params.CallbackURL != "" && s.webhookSrv != nil && finished.Status != domain.JobStatusCanceled {
+		s.webhookSrv.Deliver(&finished)
+	}
+}
+
+// newID возвращает случайный hex-идентификатор задачи, доставки или запуска.
+func newID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -3,24 +3,33 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sync"
 
 	"github.com/vo1dFl0w/market-parser/internal/domain"
 	"github.com/vo1dFl0w/market-parser/internal/repository"
 )
 
+// ParserService - парсинг категорий и поиск. Если часть страниц не удалось разобрать за все повторы,
+// методы возвращают товары остальных страниц вместе с ошибкой, содержащей *domain.PagesError.
 type ParserService interface {
-	ParseProductsByCategory(ctx context.Context, category string, address string, market string) ([]domain.Products, error)
+	ParseProductsByCategory(ctx context.Context, provider string, category string, address string, market string, mode domain.ParseMode) ([]domain.Products, error)
+	ParseProductsByCategoryForMarkets(ctx context.Context, provider string, category string, address string, markets []string, mode domain.ParseMode) ([]domain.MarketProducts, error)
+	SearchProducts(ctx context.Context, provider string, filter domain.SearchFilter, address string, market string, mode domain.ParseMode) ([]domain.Products, error)
 }
 
 type parserService struct {
-	parserRepo repository.ParserRepository
+	registry    repository.ParserRegistry
+	snapshotSrv SnapshotService
 }
 
-func NewParserService(parserRepo repository.ParserRepository) *parserService {
-	return &parserService{parserRepo: parserRepo}
+// NewParserService создаёт сервис парсинга. Парсер выбирается в registry по агрегатору и режиму.
+// Результаты парсинга категорий и поиска сохраняются снимками через snapshotSrv, если он задан.
+func NewParserService(registry repository.ParserRegistry, snapshotSrv SnapshotService) *parserService {
+	return &parserService{registry: registry, snapshotSrv: snapshotSrv}
 }
 
-func (s *parserService) ParseProductsByCategory(ctx context.Context, category string, address string, market string) ([]domain.Products, error) {
+func (s *parserService) ParseProductsByCategory(ctx context.Context, provider string, category string, address string, market string, mode domain.ParseMode) ([]domain.Products, error) {
 	if category == "" {
 		return nil, domain.ErrEmptyCategory
 	}
@@ -33,9 +42,170 @@ func (s *parserService) ParseProductsByCategory(ctx context.Context, category st
 		return nil, domain.ErrEmptyMarket
 	}
 
-	res, err := s.parserRepo.GetAllProductsByCategory(ctx, category, address, market)
+	parserRepo, err := s.registry.Parser(provider, mode)
 	if err != nil {
+		return nil, err
+	}
+
+	ctx, pages := s.collectPages(ctx)
+	res, err := parserRepo.GetAllProductsByCategory(ctx, category, address, market)
+	if err != nil && !partial(pages.products(res), err) {
 		return nil, fmt.Errorf("get all products by category: %w", err)
 	}
+	s.recordSnapshot(ctx, provider, market, address, category, pages.products(res))
+	if err != nil {
+		return res, fmt.Errorf("get all products by category: %w", err)
+	}
+
 	return res, nil
 }
+
+func (s *parserService) ParseProductsByCategoryForMarkets(ctx context.Context, provider string, category string, address string, markets []string, mode domain.ParseMode) ([]domain.MarketProducts, error) {
+	if category == "" {
+		return nil, domain.ErrEmptyCategory
+	}
+
+	if address == "" {
+		return nil, domain.ErrEmptyAddress
+	}
+
+	if len(markets) == 0 {
+		return nil, domain.ErrEmptyMarket
+	}
+
+	// убираем повторы, сохраняя порядок магазинов из запроса
+	uniqueMarkets := make([]string, 0, len(markets))
+	seen := make(map[string]struct{}, len(markets))
+	for _, market := range markets {
+		if market == "" {
+			return nil, domain.ErrEmptyMarket
+		}
+		if _, ok := seen[market]; ok {
+			continue
+		}
+		seen[market] = struct{}{}
+		uniqueMarkets = append(uniqueMarkets, market)
+	}
+
+	parserRepo, err := s.registry.Parser(provider, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := parserRepo.GetAllProductsByCategoryForMarkets(ctx, category, address, uniqueMarkets)
+	if err != nil {
+		return nil, fmt.Errorf("get all products by category for markets: %w", err)
+	}
+	for _, mp := range res {
+		if mp.Err == nil || partial(mp.Products, mp.Err) {
+			s.recordSnapshot(ctx, provider, mp.Market, address, category, mp.Products)
+		}
+	}
+
+	return res, nil
+}
+
+func (s *parserService) SearchProducts(ctx context.Context, provider string, filter domain.SearchFilter, address string, market string, mode domain.ParseMode) ([]domain.Products, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	if address == "" {
+		return nil, domain.ErrEmptyAddress
+	}
+
+	if market == "" {
+		return nil, domain.ErrEmptyMarket
+	}
+
+	parserRepo, err := s.registry.Parser(provider, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, pages := s.collectPages(ctx)
+	res, err := parserRepo.SearchProducts(ctx, filter, address, market)
+	if err != nil && !partial(pages.products(res), err) {
+		return nil, fmt.Errorf("search products: %w", err)
+	}
+	// снимок хранит выдачу магазина до фильтров сервиса, чтобы выдачи с разными фильтрами оставались сравнимы
+	s.recordSnapshot(ctx, provider, market, address, domain.SearchCategory(filter.Query), pages.products(res))
+	if err != nil {
+		err = fmt.Errorf("search products: %w", err)
+	}
+
+	// фильтры, которые магазин не применил сам, применяются к выдаче
+	return filter.Apply(res), err
+}
+
+// partial - парсер вернул товары разобранных страниц вместе с ошибкой остальных.
+func partial(products []domain.Products, err error) bool {
+	return len(products) > 0 && domain.FailedPages(err) != nil
+}
+
+// recordSnapshot сохраняет снимок категории, в том числе частичный, если часть страниц не разобрана.
+// Пустой provider сохраняется как агрегатор по умолчанию, чтобы история не зависела от формы запроса.
+func (s *parserService) recordSnapshot(ctx context.Context, provider string, market string, address string, category string, products []domain.Products) {
+	if s.snapshotSrv == nil {
+		return
+	}
+	if provider == "" {
+		provider = s.registry.DefaultProvider()
+	}
+
+	s.snapshotSrv.Record(ctx, provider, market, address, category, products)
+}
+
+// collectPages при потоковом парсинге подменяет OnPage в hooks из ctx, чтобы собрать товары для снимка:
+// парсер в этом режиме их не возвращает. Без потока или без снимков возвращает ctx как есть и nil.
+func (s *parserService) collectPages(ctx context.Context) (context.Context, *pageCollector) {
+	hooks := domain.ParseHooksFromContext(ctx)
+	if s.snapshotSrv == nil || !hooks.Streaming() {
+		return ctx, nil
+	}
+
+	c := &pageCollector{pages: make(map[int][]domain.Products)}
+	wrapped := *hooks
+	wrapped.OnPage = func(pageNum int, products []domain.Products) {
+		c.add(pageNum, products)
+		hooks.Page(pageNum, products)
+	}
+
+	return domain.WithParseHooks(ctx, &wrapped), c
+}
+
+// pageCollector - товары разобранных страниц по номеру. Повторно разобранная страница заменяет прежнюю.
+type pageCollector struct {
+	mu    sync.Mutex
+	pages map[int][]domain.Products
+}
+
+func (c *pageCollector) add(pageNum int, products []domain.Products) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pages[pageNum] = products
+}
+
+// products возвращает собранные товары в порядке страниц, а без сборщика - res парсера.
+func (c *pageCollector) products(res []domain.Products) []domain.Products {
+	if c == nil {
+		return res
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pageNums := make([]int, 0, len(c.pages))
+	for pageNum := range c.pages {
+		pageNums = append(pageNums, pageNum)
+	}
+	slices.Sort(pageNums)
+
+	products := []domain.Products{}
+	for _, pageNum := range pageNums {
+		products = append(products, c.pages[pageNum]...)
+	}
+
+	return products
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+)
+
+// stubParser отдаёт заданные товары и ошибку на любой запрос.
+type stubParser struct {
+	products []domain.Products
+	err      error
+	// pages передаются в hooks по номерам страниц, в потоковом режиме парсер товары не возвращает
+	pages map[int][]domain.Products
+}
+
+func (p *stubParser) GetAllProductsByCategory(ctx context.Context, category string, address string, market string) ([]domain.Products, error) {
+	hooks := domain.ParseHooksFromContext(ctx)
+	for pageNum, products := range p.pages {
+		hooks.Page(pageNum, products)
+	}
+	if hooks.Streaming() {
+		return []domain.Products{}, p.err
+	}
+	return p.products, p.err
+}
+
+func (p *stubParser) GetAllProductsByCategoryForMarkets(ctx context.Context, category string, address string, markets []string) ([]domain.MarketProducts, error) {
+	res := make([]domain.MarketProducts, 0, len(markets))
+	for _, market := range markets {
+		res = append(res, domain.MarketProducts{Market: market, Products: p.products, Err: p.err})
+	}
+	return res, nil
+}
+
+func (p *stubParser) SearchProducts(ctx context.Context, filter domain.SearchFilter, address string, market string) ([]domain.Products, error) {
+	return p.products, p.err
+}
+
+// stubRegistry возвращает один парсер для любого агрегатора.
+type stubRegistry struct {
+	repository.ParserRegistry
+	parser *stubParser
+}
+
+func (r *stubRegistry) Parser(provider string, mode domain.ParseMode) (repository.ParserRepository, error) {
+	return r.parser, nil
+}
+
+func (r *stubRegistry) DefaultProvider() string {
+	return "kuper"
+}
+
+// stubSnapshots запоминает записанные снимки.
+type stubSnapshots struct {
+	SnapshotService
+	recorded []domain.Snapshot
+}
+
+func (s *stubSnapshots) Record(ctx context.Context, provider string, market string, address string, category string, products []domain.Products) {
+	s.recorded = append(s.recorded, domain.Snapshot{Provider: provider, Market: market, Address: address, Category: category, Products: products})
+}
+
+func TestParserServicePartialResult(t *testing.T) {
+	pagesErr := &domain.PagesError{}
+	pagesErr.Add(3, errors.New("no products response"))
+	milk := []domain.Products{{Name: "milk", Price: 100, URL: "https://kuper.ru/metro/milk"}}
+
+	tests := []struct {
+		name         string
+		parser       *stubParser
+		wantProducts int
+		wantPages    []int
+	}{
+		{name: "complete", parser: &stubParser{products: milk}, wantProducts: 1},
+		{name: "failed pages", parser: &stubParser{products: milk, err: pagesErr}, wantProducts: 1, wantPages: []int{3}},
+		{name: "every page failed", parser: &stubParser{err: pagesErr}, wantPages: []int{3}},
+		{name: "other error", parser: &stubParser{products: milk, err: domain.ErrCaptchaBlocked}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewParserService(&stubRegistry{parser: tt.parser}, nil)
+
+			res, err := s.ParseProductsByCategory(context.Background(), "", "Молоко", "Москва, Тверская улица, 1", "metro", "")
+			if len(res) != tt.wantProducts {
+				t.Errorf("category: got %d products, want %d", len(res), tt.wantProducts)
+			}
+			if got := domain.FailedPages(err); !slices.Equal(got, tt.wantPages) {
+				t.Errorf("category: failed pages = %v, want %v", got, tt.wantPages)
+			}
+			if (err == nil) != (tt.parser.err == nil) {
+				t.Errorf("category: error = %v, want %v", err, tt.parser.err)
+			}
+
+			res, err = s.SearchProducts(context.Background(), "", domain.SearchFilter{Query: "milk"}, "Москва, Тверская улица, 1", "metro", "")
+			if len(res) != tt.wantProducts {
+				t.Errorf("search: got %d products, want %d", len(res), tt.wantProducts)
+			}
+			if (err == nil) != (tt.parser.err == nil) {
+				t.Errorf("search: error = %v, want %v", err, tt.parser.err)
+			}
+		})
+	}
+}
+
+func TestParserServiceRecordsSnapshot(t *testing.T) {
+	product := func(name string) domain.Products {
+		return domain.Products{Name: name, Price: 100, URL: "https://kuper.ru/metro/" + name}
+	}
+	pagesErr := &domain.PagesError{}
+	pagesErr.Add(3, errors.New("no products response"))
+
+	tests := []struct {
+		name   string
+		parser *stubParser
+		hooks  *domain.ParseHooks
+		want   []string
+	}{
+		{
+			name:   "category",
+			parser: &stubParser{products: []domain.Products{product("milk"), product("kefir")}},
+			want:   []string{"milk", "kefir"},
+		},
+		{
+			name:   "stream in page order",
+			parser: &stubParser{pages: map[int][]domain.Products{2: {product("kefir")}, 1: {product("milk"), product("cream")}}},
+			hooks:  &domain.ParseHooks{Stream: true},
+			want:   []string{"milk", "cream", "kefir"},
+		},
+		{
+			name:   "partial stream",
+			parser: &stubParser{pages: map[int][]domain.Products{1: {product("milk")}}, err: pagesErr},
+			hooks:  &domain.ParseHooks{Stream: true},
+			want:   []string{"milk"},
+		},
+		{
+			name:   "stream without products",
+			parser: &stubParser{err: pagesErr},
+			hooks:  &domain.ParseHooks{Stream: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshots := &stubSnapshots{}
+			s := NewParserService(&stubRegistry{parser: tt.parser}, snapshots)
+
+			streamed := 0
+			ctx := context.Background()
+			if tt.hooks != nil {
+				tt.hooks.OnPage = func(pageNum int, products []domain.Products) { streamed++ }
+				ctx = domain.WithParseHooks(ctx, tt.hooks)
+			}
+			s.ParseProductsByCategory(ctx, "", "Молоко", "Москва, Тверская улица, 1", "metro", "")
+
+			if streamed != len(tt.parser.pages) {
+				t.Errorf("streamed %d pages, want %d", streamed, len(tt.parser.pages))
+			}
+			if tt.want == nil {
+				if len(snapshots.recorded) != 0 {
+					t.Fatalf("recorded %d snapshots, want none", len(snapshots.recorded))
+				}
+				return
+			}
+			if len(snapshots.recorded) != 1 {
+				t.Fatalf("recorded %d snapshots, want 1", len(snapshots.recorded))
+			}
+			got := []string{}
+			for _, p := range snapshots.recorded[0].Products {
+				got = append(got, p.Name)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("snapshot products = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParserServiceRecordsSearchSnapshot(t *testing.T) {
+	snapshots := &stubSnapshots{}
+	parser := &stubParser{products: []domain.Products{{Name: "milk", Price: 100}, {Name: "kefir", Price: 300}}}
+	s := NewParserService(&stubRegistry{parser: parser}, snapshots)
+
+	priceMax := 200.0
+	res, err := s.SearchProducts(context.Background(), "", domain.SearchFilter{Query: " молоко ", PriceMax: &priceMax}, "Москва, Тверская улица, 1", "metro", "")
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if len(res) != 1 {
+		t.Errorf("got %d products, want 1 after price filter", len(res))
+	}
+	if len(snapshots.recorded) != 1 {
+		t.Fatalf("recorded %d snapshots, want 1", len(snapshots.recorded))
+	}
+	if got := snapshots.recorded[0]; got.Provider != "kuper" || got.Category != "search:молоко" || len(got.Products) != 2 {
+		t.Errorf("snapshot = %s %s with %d products, want default provider kuper, search:молоко with 2", got.Provider, got.Category, len(got.Products))
+	}
+}
@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+type SchedulerService interface {
+	Statuses(ctx context.Context) ([]domain.ScheduleStatus, error)
+	Runs(ctx context.Context, name string, limit int) ([]domain.ScheduleRun, error)
+}
+
+type scheduleState struct {
+	schedule domain.Schedule
+	entryID  cron.EntryID
+	running  atomic.Bool
+
+	mu      sync.Mutex
+	lastRun *domain.ScheduleRun
+}
+
+// schedulerService запускает парсинг целей расписаний по cron внутри процесса.
+// Запуски одного расписания не пересекаются: если предыдущий ещё идёт, новый пропускается
+// и попадает в историю со статусом skipped.
+type schedulerService struct {
+	parserSrv     ParserService
+	runRepo       repository.ScheduleRunRepository
+	targetTimeout time.Duration
+	errMessage    func(error) string
+	logger        logger.Logger
+
+	cron      *cron.Cron
+	schedules map[string]*scheduleState
+	order     []string
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewSchedulerService создаёт планировщик расписаний. errMessage превращает ошибку цели в сообщение
+// для истории запусков без внутренних подробностей: история отдаётся через api.
+func NewSchedulerService(logger logger.Logger, parserSrv ParserService, runRepo repository.ScheduleRunRepository, schedules []domain.Schedule, targetTimeout time.Duration, errMessage func(error) string) (*schedulerService, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := &schedulerService{
+		parserSrv:     parserSrv,
+		runRepo:       runRepo,
+		targetTimeout: targetTimeout,
+		errMessage:    errMessage,
+		logger:        logger,
+		cron:          cron.New(),
+		schedules:     make(map[string]*scheduleState, len(schedules)),
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+
+	for _, schedule := range schedules {
+		if schedule.Name == "" {
+			cancel()
+			return nil, fmt.Errorf("schedule without name")
+		}
+		if _, ok := s.schedules[schedule.Name]; ok {
+			cancel()
+			return nil, fmt.Errorf("duplicate schedule %q", schedule.Name)
+		}
+		if len(schedule.Targets) == 0 {
+			cancel()
+			return nil, fmt.Errorf("schedule %q has no targets", schedule.Name)
+		}
+
+		st := &scheduleState{schedule: schedule}
+		entryID, err := s.cron.AddFunc(schedule.Cron, func() { s.trigger(st) })
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("schedule %q cron %q: %w", schedule.Name, schedule.Cron, err)
+		}
+		st.entryID = entryID
+
+		s.schedules[schedule.Name] = st
+		s.order = append(s.order, schedule.Name)
+	}
+
+	return s, nil
+}
+
+func (s *schedulerService) Start() {
+	s.cron.Start()
+}
+
+// Close останавливает cron, прерывает идущие запуски и ждёт их завершения.
+func (s *schedulerService) Close(ctx context.Context) error {
+	s.cancel()
+	stopped := s.cron.Stop()
+
+	select {
+	case <-stopped.Done():
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("wait schedule runs: %w", ctx.Err())
+	}
+}
+
+func (s *schedulerService) Statuses(ctx context.Context) ([]domain.ScheduleStatus, error) {
+	res := make([]domain.ScheduleStatus, 0, len(s.order))
+	for _, name := range s.order {
+		st := s.schedules[name]
+
+		lastRun, err := s.lastRun(ctx, st)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, domain.ScheduleStatus{
+			Schedule: st.schedule,
+			Running:  st.running.Load(),
+			NextRun:  s.cron.Entry(st.entryID).Next,
+			LastRun:  lastRun,
+		})
+	}
+
+	return res, nil
+}
+
+func (s *schedulerService) Runs(ctx context.Context, name string, limit int) ([]domain.ScheduleRun, error) {
+	if _, ok := s.schedules[name]; !ok {
+		return nil, fmt.Errorf("%w: %s", domain.ErrScheduleNotFound, name)
+	}
+
+	res, err := s.runRepo.ListRuns(ctx, name, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list schedule runs: %w", err)
+	}
+
+	return res, nil
+}
+
+// lastRun берёт последний запуск из памяти, а после перезапуска сервиса - из истории.
+func (s *schedulerService) lastRun(ctx context.Context, st *scheduleState) (*domain.ScheduleRun, error) {
+	st.mu.Lock()
+	lastRun := st.lastRun
+	st.mu.Unlock()
+	if lastRun != nil {
+		run := *lastRun
+		return &run, nil
+	}
+
+	runs, err := s.runRepo.ListRuns(ctx, st.schedule.Name, 1)
+	if err != nil {
+		return nil, fmt.Errorf("list schedule runs: %w", err)
+	}
+	if len(runs) == 0 {
+		return nil, nil
+	}
+
+	return &runs[0], nil
+}
+
+func (s *schedulerService) trigger(st *scheduleState) {
+	name := st.schedule.Name
+
+	if !st.running.CompareAndSwap(false, true) {
+		s.logger.Warn("schedule run skipped, previous run in progress", "schedule", name)
+		now := time.Now()
+		s.saveRun(st, &domain.ScheduleRun{
+			Schedule:   name,
+			Status:     domain.ScheduleRunSkipped,
+			StartedAt:  now,
+			FinishedAt: now,
+		})
+		return
+	}
+	defer st.running.Store(false)
+
+	// пауза до Jitter разносит расписания с одинаковым cron во времени
+	if st.schedule.Jitter > 0 {
+		select {
+		case <-time.After(time.Duration(rand.Int63n(int64(st.schedule.Jitter)))):
+		case <-s.ctx.Done():
+			return
+		}
+	}
+
+	s.run(st)
+}
+
+func (s *schedulerService) run(st *scheduleState) {
+	schedule := st.schedule
+	run := &domain.ScheduleRun{
+		Schedule:  schedule.Name,
+		Status:    domain.ScheduleRunRunning,
+		Targets:   make([]domain.ScheduleTargetResult, 0, len(schedule.Targets)),
+		StartedAt: time.Now(),
+	}
+	s.saveRun(st, run)
+	s.logger.Info("schedule run started", "schedule", schedule.Name, "targets", len(schedule.Targets))
+
+	failed := 0
+	for _, target := range schedule.Targets {
+		if s.ctx.Err() != nil {
+			break
+		}
+
+		start := time.Now()
+		ctx, cancel := context.WithTimeout(domain.WithSnapshotSource(s.ctx, domain.SnapshotSourceSchedule), s.targetTimeout)
+		products, err := s.parserSrv.ParseProductsByCategory(ctx, target.Provider, target.Category, target.Address, target.Market, schedule.Mode)
+		cancel()
+
+		result := domain.ScheduleTargetResult{Target: target, Products: len(products), Duration: time.Since(start)}
+		if err != nil {
+			failed++
+			result.Err = s.errMessage(err)
+			s.logger.Warn("schedule target failed", "schedule", schedule.Name, "provider", target.Provider, "market", target.Market, "category", target.Category, "error", err)
+		}
+		run.Targets = append(run.Targets, result)
+	}
+
+	run.FinishedAt = time.Now()
+	switch {
+	case failed == 0 && len(run.Targets) == len(schedule.Targets):
+		run.Status = domain.ScheduleRunSuccess
+	case failed == len(run.Targets):
+		run.Status = domain.ScheduleRunFailed
+	default:
+		run.Status = domain.ScheduleRunPartial
+	}
+	s.saveRun(st, run)
+	s.logger.Info("schedule run finished", "schedule", schedule.Name, "status", run.Status, "failed_targets", failed)
+}
+
+func (s *schedulerService) saveRun(st *scheduleState, run *domain.ScheduleRun) {
+	if run.ID == "" {
+		id, err := newID()
+		if err != nil {
+			s.logger.Error("new schedule run id", "schedule", run.Schedule, "error", err)
+			return
+		}
+		run.ID = id
+	}
+
+	// пропущенный запуск не заменяет последний настоящий
+	if run.Status != domain.ScheduleRunSkipped {
+		saved := *run
+		st.mu.Lock()
+		st.lastRun = &saved
+		st.mu.Unlock()
+	}
+
+	if err := s.runRepo.SaveRun(context.WithoutCancel(s.ctx), run); err != nil {
+		s.logger.Error("save schedule run", "schedule", run.Schedule, "error", err)
+	}
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/adapters/storage/memory"
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+func TestSchedulerServiceRunSanitizesTargetError(t *testing.T) {
+	log := logger.LoadLogger(logger.NewLoggerConfig("local", time.Kitchen).WithOutput(io.Discard))
+	parserSrv := NewParserService(&stubRegistry{parser: &stubParser{err: errors.New("dial tcp 10.0.0.5:9222: connection refused")}}, nil)
+	schedule := domain.Schedule{Name: "milk", Cron: "@hourly", Targets: []domain.ScheduleTarget{
+		{Market: "metro", Address: "Москва, Тверская улица, 1", Category: "Молоко"},
+	}}
+	errMessage := func(err error) string { return "internal server error" }
+
+	s, err := NewSchedulerService(log, parserSrv, memory.NewScheduleRunRepository(10), []domain.Schedule{schedule}, time.Second, errMessage)
+	if err != nil {
+		t.Fatalf("new scheduler service: %v", err)
+	}
+	t.Cleanup(func() { s.Close(context.Background()) })
+
+	s.run(s.schedules["milk"])
+
+	runs, err := s.Runs(context.Background(), "milk", 10)
+	if err != nil {
+		t.Fatalf("runs: %v", err)
+	}
+	if len(runs) != 1 || len(runs[0].Targets) != 1 {
+		t.Fatalf("runs = %+v, want 1 run with 1 target", runs)
+	}
+	if runs[0].Status != domain.ScheduleRunFailed {
+		t.Errorf("status = %s, want %s", runs[0].Status, domain.ScheduleRunFailed)
+	}
+	if got := runs[0].Targets[0].Err; got != "internal server error" {
+		t.Errorf("target error = %q, want sanitized message", got)
+	}
+}
@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+const (
+	defaultSnapshotsLimit = 50
+	maxSnapshotsLimit     = 1000
+)
+
+type SnapshotService interface {
+	Record(ctx context.Context, provider string, market string, address string, category string, products []domain.Products)
+	GetSnapshot(ctx context.Context, id string) (*domain.Snapshot, error)
+	ListSnapshots(ctx context.Context, filter domain.SnapshotFilter) ([]domain.Snapshot, error)
+	PriceHistory(ctx context.Context, filter domain.PriceHistoryFilter) (*domain.PriceHistory, error)
+	DiffSnapshots(ctx context.Context, fromID string, toID string) (*domain.SnapshotDiff, error)
+}
+
+type snapshotService struct {
+	snapshotRepo    repository.SnapshotRepository
+	alertSrv        AlertService
+	defaultProvider string
+	logger          logger.Logger
+}
+
+// NewSnapshotService создаёт сервис снимков. Каждый новый снимок проверяется правилами оповещений alertSrv, если он задан.
+// История цен без агрегатора строится по снимкам defaultProvider.
+func NewSnapshotService(logger logger.Logger, snapshotRepo repository.SnapshotRepository, alertSrv AlertService, defaultProvider string) *snapshotService {
+	return &snapshotService{snapshotRepo: snapshotRepo, alertSrv: alertSrv, defaultProvider: defaultProvider, logger: logger}
+}
+
+// Record сохраняет результат парсинга категории как снимок. Ошибка хранилища не должна
+// лишать вызывающего уже полученного результата, поэтому она только логируется.
+func (s *snapshotService) Record(ctx context.Context, provider string, market string, address string, category string, products []domain.Products) {
+	id, err := newID()
+	if err != nil {
+		s.logger.Error("new snapshot id", "market", market, "category", category, "error", err)
+		return
+	}
+
+	snapshot := &domain.Snapshot{
+		ID:            id,
+		Provider:      provider,
+		Market:        market,
+		Address:       address,
+		Category:      category,
+		Source:        domain.SnapshotSourceFromContext(ctx),
+		CreatedAt:     time.Now(),
+		ProductsCount: len(products),
+		Products:      products,
+	}
+	// запрос мог истечь сразу после парсинга, снимок всё равно сохраняется
+	ctx = context.WithoutCancel(ctx)
+
+	// предыдущий снимок ищется до сохранения нового
+	var prev *domain.Snapshot
+	if s.alertSrv != nil {
+		prev, err = s.previous(ctx, snapshot)
+		if err != nil {
+			s.logger.Error("get previous snapshot", "market", market, "category", category, "error", err)
+		}
+	}
+
+	if err := s.snapshotRepo.SaveSnapshot(ctx, snapshot); err != nil {
+		s.logger.Error("save snapshot", "market", market, "category", category, "error", err)
+		return
+	}
+
+	if s.alertSrv != nil {
+		s.alertSrv.Check(ctx, prev, snapshot)
+	}
+}
+
+// previous возвращает последний сохранённый снимок той же категории магазина по адресу от того же агрегатора или nil.
+func (s *snapshotService) previous(ctx context.Context, snapshot *domain.Snapshot) (*domain.Snapshot, error) {
+	list, err := s.snapshotRepo.ListSnapshots(ctx, domain.SnapshotFilter{
+		Provider: snapshot.Provider,
+		Market:   snapshot.Market,
+		Address:  snapshot.Address,
+		Category: snapshot.Category,
+		Limit:    1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list snapshots: %w", err)
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	res, err := s.snapshotRepo.GetSnapshot(ctx, list[0].ID)
+	if err != nil {
+		return nil, fmt.Errorf("get snapshot: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *snapshotService) GetSnapshot(ctx context.Context, id string) (*domain.Snapshot, error) {
+	res, err := s.snapshotRepo.GetSnapshot(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get snapshot: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *snapshotService) ListSnapshots(ctx context.Context, filter domain.SnapshotFilter) ([]domain.Snapshot, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultSnapshotsLimit
+	}
+	filter.Limit = min(filter.Limit, maxSnapshotsLimit)
+
+	res, err := s.snapshotRepo.ListSnapshots(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("list snapshots: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *snapshotService) PriceHistory(ctx context.Context, filter domain.PriceHistoryFilter) (*domain.PriceHistory, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("validate price history filter: %w", err)
+	}
+	if filter.Provider == "" {
+		filter.Provider = s.defaultProvider
+	}
+
+	points, err := s.snapshotRepo.ListPricePoints(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("list price points: %w", err)
+	}
+	if len(points) == 0 {
+		return nil, fmt.Errorf("%w: %s", domain.ErrPriceHistoryEmpty, filter.ProductID)
+	}
+
+	return domain.NewPriceHistory(filter, points), nil
+}
+
+// DiffSnapshots сравнивает два снимка одной категории магазина по одному адресу от одного агрегатора.
+func (s *snapshotService) DiffSnapshots(ctx context.Context, fromID string, toID string) (*domain.SnapshotDiff, error) {
+	from, err := s.snapshotRepo.GetSnapshot(ctx, fromID)
+	if err != nil {
+		return nil, fmt.Errorf("get snapshot: %w", err)
+	}
+	to, err := s.snapshotRepo.GetSnapshot(ctx, toID)
+	if err != nil {
+		return nil, fmt.Errorf("get snapshot: %w", err)
+	}
+
+	if from.Provider != to.Provider || from.Market != to.Market || from.Category != to.Category || domain.NormalizeAddress(from.Address) != domain.NormalizeAddress(to.Address) {
+		return nil, fmt.Errorf("%w: %s, %s", domain.ErrSnapshotsMismatch, fromID, toID)
+	}
+
+	res := domain.DiffProducts(from.Products, to.Products)
+	// в ответе нужны только сведения о снимках, товары уже разложены по изменениям
+	from.Products, to.Products = nil, nil
+	res.From, res.To = from, to
+
+	return res, nil
+}
@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/vo1dFl0w/market-parser/internal/domain"
+	"github.com/vo1dFl0w/market-parser/internal/repository"
+	"github.com/vo1dFl0w/market-parser/pkg/logger"
+)
+
+const (
+	defaultDeliveriesLimit = 100
+	maxDeliveriesLimit     = 1000
+)
+
+type WebhookService interface {
+	Deliver(job *domain.Job)
+	GetDelivery(ctx context.Context, id string) (*domain.WebhookDelivery, error)
+	ListDeliveries(ctx context.Context, filter domain.DeliveryFilter) ([]domain.WebhookDelivery, error)
+}
+
+// webhookService отправляет результаты задач на callback_url в фоне. Неудачные попытки повторяются
+// с экспоненциальной паузой от initialBackoff до maxBackoff, каждая попытка пишется в журнал доставок.
+// Очередь доставок хранится только в памяти процесса.
+type webhookService struct {
+	deliveryRepo   repository.DeliveryRepository
+	sender         repository.WebhookSender
+	sendTimeout    time.Duration
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	logger         logger.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewWebhookService(logger logger.Logger, deliveryRepo repository.DeliveryRepository, sender repository.WebhookSender, sendTimeout time.Duration, maxAttempts int, initialBackoff time.Duration, maxBackoff time.Duration) *webhookService {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &webhookService{
+		deliveryRepo:   deliveryRepo,
+		sender:         sender,
+		sendTimeout:    sendTimeout,
+		maxAttempts:    max(maxAttempts, 1),
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+		logger:         logger,
+		ctx:            ctx,
+		cancel:         cancel,
+	}
+}
+
+// Deliver ставит отправку результата задачи на job.Params.CallbackURL и сразу возвращается.
+func (s *webhookService) Deliver(job *domain.Job) {
+	id, err := newID()
+	if err != nil {
+		s.logger.Error("new delivery id", "job_id", job.ID, "error", err)
+		return
+	}
+
+	delivery := &domain.WebhookDelivery{
+		ID:        id,
+		JobID:     job.ID,
+		URL:       job.Params.CallbackURL,
+		Status:    domain.DeliveryStatusPending,
+		CreatedAt: time.Now(),
+	}
+	if err := s.deliveryRepo.SaveDelivery(s.ctx, delivery); err != nil {
+		s.logger.Error("save delivery", "job_id", job.ID, "error", err)
+		return
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.deliver(delivery, job)
+	}()
+}
+
+func (s *webhookService) deliver(delivery *domain.WebhookDelivery, job *domain.Job) {
+	backoff := s.initialBackoff
+
+	for attempt := 1; attempt <= s.maxAttempts; attempt++ {
+		start := time.Now()
+		statusCode, err := s.send(delivery, job)
+
+		a := domain.WebhookAttempt{Attempt: attempt, At: start, StatusCode: statusCode, Duration: time.Since(start)}
+		if err != nil {
+			a.Err = err.Error()
+		}
+		delivery.Attempts = append(delivery.Attempts, a)
+
+		switch {
+		case err == nil:
+			delivery.Status = domain.DeliveryStatusDelivered
+			delivery.DeliveredAt = time.Now()
+		case !retryable(statusCode) || attempt == s.maxAttempts:
+			delivery.Status = domain.DeliveryStatusFailed
+		}
+		// запись обновляется после каждой попытки, чтобы журнал показывал ход доставки.
+		// Последняя попытка сохраняется и во время остановки сервиса
+		if err := s.deliveryRepo.SaveDelivery(context.WithoutCancel(s.ctx), delivery); err != nil {
+			s.logger.Error("save delivery", "delivery_id", delivery.ID, "error", err)
+		}
+		switch delivery.Status {
+		case domain.DeliveryStatusDelivered:
+			s.logger.Info("webhook delivered", "delivery_id", delivery.ID, "job_id", delivery.JobID, "attempt", attempt)
+			return
+		case domain.DeliveryStatusFailed:
+			s.logger.Warn("webhook delivery failed", "delivery_id", delivery.ID, "job_id", delivery.JobID, "attempt", attempt, "error", err)
+			return
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-s.ctx.Done():
+			// сервис останавливается, доставка остаётся в журнале со статусом pending
+			return
+		}
+		backoff = min(backoff*2, s.maxBackoff)
+	}
+}
+
+// send отправляет одну попытку со своим таймаутом. Остановка сервиса не прерывает запрос,
+// который уже отправляется, а только паузу перед следующей попыткой.
+func (s *webhookService) send(delivery *domain.WebhookDelivery, job *domain.Job) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.sendTimeout)
+	defer cancel()
+
+	return s.sender.Send(ctx, delivery.ID, delivery.URL, job)
+}
+
+// retryable - ответ не получен, получатель перегружен или временно недоступен.
+// Остальные 4xx означают, что запрос не примут и при повторе.
+func retryable(statusCode int) bool {
+	return statusCode == 0 ||
+		statusCode == http.StatusRequestTimeout ||
+		statusCode == http.StatusTooManyRequests ||
+		statusCode >= http.StatusInternalServerError
+}
+
+func (s *webhookService) GetDelivery(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	res, err := s.deliveryRepo.GetDelivery(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get delivery: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *webhookService) ListDeliveries(ctx context.Context, filter domain.DeliveryFilter) ([]domain.WebhookDelivery, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultDeliveriesLimit
+	}
+	filter.Limit = min(filter.Limit, maxDeliveriesLimit)
+
+	res, err := s.deliveryRepo.ListDeliveries(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("list deliveries: %w", err)
+	}
+
+	return res, nil
+}
+
+// Close прерывает паузы между попытками и ждёт завершения отправок, которые уже идут. Доставки, ожидавшие
+// повтора, остаются со статусом pending и после перезапуска не отправляются.
+func (s *webhookService) Close(ctx context.Context) error {
+	s.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("wait webhook deliveries: %w", ctx.Err())
+	}
+}
@@ -1,10 +1,22 @@
 package logger
 
+import (
+	"io"
+	"os"
+)
+
 type Config struct {
-	env          string
+	env              string
 	loggerTimeFormat string
+	output           io.Writer
 }
 
 func NewLoggerConfig(env string, loggerTimeFormat string) *Config {
-	return &Config{env: env, loggerTimeFormat: loggerTimeFormat}
-}
\ No newline at end of file
+	return &Config{env: env, loggerTimeFormat: loggerTimeFormat, output: os.Stdout}
+}
+
+// WithOutput меняет поток логов, по умолчанию os.Stdout.
+func (c *Config) WithOutput(output io.Writer) *Config {
+	c.output = output
+	return c
+}
@@ -46,7 +46,7 @@ func LoadLogger(cfg *Config) *logger {
 
 	switch cfg.env {
 	case envLocal:
-		handler = tint.NewHandler(os.Stdout, &tint.Options{
+		handler = tint.NewHandler(cfg.output, &tint.Options{
 			Level:      slog.LevelDebug,
 			TimeFormat: cfg.loggerTimeFormat,
 			AddSource:  false,